@@ -0,0 +1,102 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// AK represents a SCCP Data Acknowledgement message, used for class 3
+// flow control.
+type AK struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	ReceiveSeqNumber    uint8
+	Credit              uint8
+}
+
+// NewAK creates a new AK.
+func NewAK(destinationLocalRef []byte, receiveSeqNumber, credit uint8) *AK {
+	return &AK{
+		Type:                MsgTypeAK,
+		DestinationLocalRef: destinationLocalRef,
+		ReceiveSeqNumber:    receiveSeqNumber,
+		Credit:              credit,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a AK instance.
+func (a *AK) MarshalBinary() ([]byte, error) {
+	b := make([]byte, a.MarshalLen())
+	if err := a.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (a *AK) MarshalTo(b []byte) error {
+	if len(b) < 6 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(a.Type)
+	copy(b[1:4], a.DestinationLocalRef)
+	b[4] = a.ReceiveSeqNumber
+	b[5] = a.Credit
+
+	return nil
+}
+
+// ParseAK decodes given byte sequence as a SCCP AK.
+func ParseAK(b []byte) (*AK, error) {
+	a := &AK{}
+	if err := a.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP AK.
+func (a *AK) UnmarshalBinary(b []byte) error {
+	if len(b) < 6 {
+		return io.ErrUnexpectedEOF
+	}
+
+	a.Type = MsgType(b[0])
+	a.DestinationLocalRef = b[1:4]
+	a.ReceiveSeqNumber = b[4]
+	a.Credit = b[5]
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (a *AK) MarshalLen() int {
+	return 6
+}
+
+// String returns the AK values in human readable format.
+func (a *AK) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, ReceiveSeqNumber: %d, Credit: %d}",
+		a.Type,
+		a.DestinationLocalRef,
+		a.ReceiveSeqNumber,
+		a.Credit,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (a *AK) MessageType() MsgType {
+	return MsgTypeAK
+}
+
+// MessageTypeName returns the Message Type in string.
+func (a *AK) MessageTypeName() string {
+	return "AK"
+}
@@ -0,0 +1,124 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// CC represents a SCCP Connection Confirm message.
+type CC struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	SourceLocalRef      []byte
+	ProtocolClass       uint8
+	Ptr1                uint8 // pointer to optional part, 0 if absent
+	OptionalPart        []byte
+}
+
+// NewCC creates a new CC.
+func NewCC(destinationLocalRef, sourceLocalRef []byte, protocolClass uint8) *CC {
+	return &CC{
+		Type:                MsgTypeCC,
+		DestinationLocalRef: destinationLocalRef,
+		SourceLocalRef:      sourceLocalRef,
+		ProtocolClass:       protocolClass,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a CC instance.
+func (c *CC) MarshalBinary() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (c *CC) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 9 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(c.Type)
+	copy(b[1:4], c.DestinationLocalRef)
+	copy(b[4:7], c.SourceLocalRef)
+	b[7] = c.ProtocolClass
+	b[8] = c.Ptr1
+
+	if c.Ptr1 != 0 {
+		offset := int(c.Ptr1) + 8
+		if l < offset+len(c.OptionalPart) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[offset:], c.OptionalPart)
+	}
+
+	return nil
+}
+
+// ParseCC decodes given byte sequence as a SCCP CC.
+func ParseCC(b []byte) (*CC, error) {
+	c := &CC{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP CC.
+func (c *CC) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 9 {
+		return io.ErrUnexpectedEOF
+	}
+
+	c.Type = MsgType(b[0])
+	c.DestinationLocalRef = b[1:4]
+	c.SourceLocalRef = b[4:7]
+	c.ProtocolClass = b[7]
+	c.Ptr1 = b[8]
+
+	if c.Ptr1 != 0 {
+		offset := int(c.Ptr1) + 8
+		if l < offset {
+			return io.ErrUnexpectedEOF
+		}
+		c.OptionalPart = b[offset:]
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (c *CC) MarshalLen() int {
+	return 9 + len(c.OptionalPart)
+}
+
+// String returns the CC values in human readable format.
+func (c *CC) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, SourceLocalRef: %v, ProtocolClass: %d, OptionalPart: %x}",
+		c.Type,
+		c.DestinationLocalRef,
+		c.SourceLocalRef,
+		c.ProtocolClass,
+		c.OptionalPart,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (c *CC) MessageType() MsgType {
+	return MsgTypeCC
+}
+
+// MessageTypeName returns the Message Type in string.
+func (c *CC) MessageTypeName() string {
+	return "CC"
+}
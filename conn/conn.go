@@ -0,0 +1,495 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+/*
+Package conn implements a connection-oriented SCCP endpoint (protocol
+classes 2 and 3) on top of a pluggable, message-oriented transport such as
+an M3UA association over SCTP. It drives the SCCP connection state machine
+(idle -> connection pending -> active -> disconnect pending -> idle) from
+incoming CR/CC/CREF/RLSD/RLC messages and exposes the result as a
+Dial/Listen/Accept/Read/Write/Close API modelled on net.Conn.
+
+A Conn owns its Transport exclusively: this package does not multiplex
+several SCCP connections onto one association by local reference, it
+assumes one Transport carries exactly one connection.
+*/
+package conn
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/stuchl4n3k/go-sccp"
+)
+
+// state is a Conn's position in the SCCP connection state machine
+// (ITU-T Q.713 §6).
+type state int
+
+const (
+	stateIdle state = iota
+	stateConnPending
+	stateActive
+	stateDisconnPending
+	stateClosed
+)
+
+// Errors returned by Conn and Listener methods.
+var (
+	ErrConnectionRefused = errors.New("sccp/conn: connection refused")
+	ErrNotActive         = errors.New("sccp/conn: connection is not active")
+	ErrClosed            = errors.New("sccp/conn: connection closed")
+	ErrWindowExhausted   = errors.New("sccp/conn: send window exhausted, waiting for AK")
+)
+
+// Transport is the minimal interface a Conn needs from the layer below. It
+// is intentionally io.ReadWriter-shaped so any message-oriented channel (an
+// SCTP/M3UA association, a test pipe, ...) can be plugged in directly: each
+// Write must carry exactly one serialized SCCP message, and each Read must
+// return exactly one.
+type Transport = io.ReadWriter
+
+// DefaultInactivityTimeout is how long a Conn waits without traffic before
+// sending an IT message to probe the peer.
+const DefaultInactivityTimeout = 30 * time.Second
+
+// DefaultCredit is the class 3 receive window size advertised in AK/IT when
+// no application-specific value is configured.
+const DefaultCredit = 8
+
+// Conn is a connection-oriented SCCP endpoint driven by the class 2/3
+// connection state machine.
+type Conn struct {
+	transport Transport
+
+	mu    sync.Mutex
+	state state
+
+	localRef  []byte
+	remoteRef []byte
+
+	protocolClass uint8
+
+	// class 3 flow control (Q.713 §6.3.2)
+	sendSeq    uint8
+	sendCredit uint8
+	recvSeq    uint8
+	recvCredit uint8
+
+	reassembly []byte
+	readBuf    []byte
+
+	lastActivity time.Time
+
+	incoming  chan []byte
+	ccCh      chan *sccp.CC
+	crefCh    chan *sccp.CREF
+	rlcCh     chan *sccp.RLC
+	errCh     chan error
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+var localRefCounter uint32
+
+// newLocalRef allocates a locally-unique 3-octet local reference.
+func newLocalRef() []byte {
+	n := atomic.AddUint32(&localRefCounter, 1)
+	return []byte{byte(n >> 16), byte(n >> 8), byte(n)}
+}
+
+func newConn(t Transport, protocolClass uint8) *Conn {
+	return &Conn{
+		transport:     t,
+		state:         stateConnPending,
+		protocolClass: protocolClass,
+		localRef:      newLocalRef(),
+		sendCredit:    DefaultCredit,
+		recvCredit:    DefaultCredit,
+		lastActivity:  time.Now(),
+		incoming:      make(chan []byte, 16),
+		ccCh:          make(chan *sccp.CC, 1),
+		crefCh:        make(chan *sccp.CREF, 1),
+		rlcCh:         make(chan *sccp.RLC, 1),
+		errCh:         make(chan error, 1),
+		closeCh:       make(chan struct{}),
+	}
+}
+
+// Dial establishes a connection-oriented SCCP connection over t: it sends a
+// CR addressed to calledAddr, carrying callingAddr as the optional Calling
+// Party Address if non-nil, and blocks until a CC or CREF is received.
+func Dial(t Transport, protocolClass uint8, calledAddr, callingAddr *sccp.PartyAddress) (*Conn, error) {
+	c := newConn(t, protocolClass)
+
+	go c.serve()
+	go c.watchInactivity()
+
+	cr := sccp.NewCR(c.localRef, protocolClass, calledAddr, callingAddr)
+	if err := c.send(cr); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	select {
+	case cc := <-c.ccCh:
+		c.mu.Lock()
+		c.remoteRef = cc.SourceLocalRef
+		c.state = stateActive
+		c.mu.Unlock()
+		return c, nil
+	case cref := <-c.crefCh:
+		c.Close()
+		return nil, fmt.Errorf("%w: cause %d", ErrConnectionRefused, cref.RefusalCause)
+	case err := <-c.errCh:
+		return nil, err
+	}
+}
+
+// Listener accepts incoming SCCP connection requests on a Transport.
+type Listener struct {
+	transport     Transport
+	protocolClass uint8
+}
+
+// Listen returns a Listener that accepts class 2/3 connections over t.
+func Listen(t Transport, protocolClass uint8) *Listener {
+	return &Listener{transport: t, protocolClass: protocolClass}
+}
+
+// Accept blocks until a CR is received on the Listener's Transport, confirms
+// it with a CC, and returns the resulting active Conn.
+func (l *Listener) Accept() (*Conn, error) {
+	msg, err := readMessage(l.transport)
+	if err != nil {
+		return nil, err
+	}
+
+	cr, ok := msg.(*sccp.CR)
+	if !ok {
+		return nil, fmt.Errorf("sccp/conn: expected CR, got %s", msg.MessageTypeName())
+	}
+
+	c := newConn(l.transport, l.protocolClass)
+	c.remoteRef = cr.SourceLocalRef
+
+	cc := sccp.NewCC(c.remoteRef, c.localRef, l.protocolClass)
+	if err := c.send(cc); err != nil {
+		return nil, err
+	}
+	c.state = stateActive
+
+	go c.serve()
+	go c.watchInactivity()
+
+	return c, nil
+}
+
+// serve reads and dispatches messages for the lifetime of the connection.
+// There is exactly one serve goroutine per Conn.
+func (c *Conn) serve() {
+	for {
+		msg, err := readMessage(c.transport)
+		if err != nil {
+			select {
+			case c.errCh <- err:
+			default:
+			}
+			return
+		}
+
+		c.mu.Lock()
+		c.lastActivity = time.Now()
+		c.mu.Unlock()
+
+		switch m := msg.(type) {
+		case *sccp.CC:
+			select {
+			case c.ccCh <- m:
+			default:
+			}
+		case *sccp.CREF:
+			select {
+			case c.crefCh <- m:
+			default:
+			}
+		case *sccp.DT1:
+			c.incoming <- append([]byte{}, m.Data...)
+		case *sccp.DT2:
+			if err := c.handleDT2(m); err != nil {
+				select {
+				case c.errCh <- err:
+				default:
+				}
+				return
+			}
+		case *sccp.AK:
+			c.handleAK(m)
+		case *sccp.IT:
+			c.handleIT(m)
+		case *sccp.RLSD:
+			c.handleRLSD(m)
+		case *sccp.RLC:
+			select {
+			case c.rlcCh <- m:
+			default:
+			}
+		case *sccp.ERR:
+			// Protocol error reported by the peer; surface it to Read.
+			select {
+			case c.errCh <- fmt.Errorf("sccp/conn: peer reported PDU error, cause %d", m.ErrorCause):
+			default:
+			}
+			return
+		default:
+			// Not meaningful for an established connection; ignore.
+		}
+
+		if c.isClosed() {
+			return
+		}
+	}
+}
+
+// maxReassemblySize bounds how large a DT2 reassembly may grow before it is
+// rejected as a protocol violation, mirroring the cap sccp.Reassembler
+// enforces for DT1/XUDT reassembly.
+const maxReassemblySize = sccp.DefaultMaxSDUSize
+
+func (c *Conn) handleDT2(m *sccp.DT2) error {
+	c.mu.Lock()
+
+	seq := m.SendSequenceNumber()
+	if expected := (c.recvSeq + 1) & 0x7f; seq != expected {
+		c.reassembly = nil
+		c.mu.Unlock()
+		return fmt.Errorf("sccp/conn: DT2 received out of order: got P(S)=%d, want %d", seq, expected)
+	}
+	if len(c.reassembly)+len(m.Data) > maxReassemblySize {
+		c.reassembly = nil
+		c.mu.Unlock()
+		return fmt.Errorf("sccp/conn: DT2 reassembly exceeds %d bytes", maxReassemblySize)
+	}
+
+	c.recvSeq = seq
+	c.reassembly = append(c.reassembly, m.Data...)
+	more := m.MoreData()
+	var data []byte
+	if !more {
+		data = c.reassembly
+		c.reassembly = nil
+	}
+	c.recvCredit--
+	if c.recvCredit == 0 {
+		c.recvCredit = DefaultCredit
+	}
+	ak := sccp.NewAK(c.remoteRef, c.recvSeq, c.recvCredit)
+	c.mu.Unlock()
+
+	if data != nil {
+		c.incoming <- data
+	}
+	_ = c.send(ak)
+	return nil
+}
+
+func (c *Conn) handleAK(m *sccp.AK) {
+	c.mu.Lock()
+	c.sendCredit = m.Credit
+	c.mu.Unlock()
+}
+
+func (c *Conn) handleIT(m *sccp.IT) {
+	c.mu.Lock()
+	localRef, remoteRef, protocolClass := c.localRef, c.remoteRef, c.protocolClass
+	c.mu.Unlock()
+
+	_ = c.send(sccp.NewIT(remoteRef, localRef, protocolClass, m.SequencingSegmenting, m.Credit))
+}
+
+// watchInactivity sends a probing IT once DefaultInactivityTimeout has
+// passed without any traffic sent or received, and keeps doing so for as
+// long as the connection stays idle. There is exactly one watchInactivity
+// goroutine per Conn, started alongside serve.
+func (c *Conn) watchInactivity() {
+	ticker := time.NewTicker(DefaultInactivityTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.mu.Lock()
+			idle := c.state == stateActive && time.Since(c.lastActivity) >= DefaultInactivityTimeout
+			localRef, remoteRef, protocolClass, recvCredit := c.localRef, c.remoteRef, c.protocolClass, c.recvCredit
+			if idle {
+				c.lastActivity = time.Now()
+			}
+			c.mu.Unlock()
+
+			if idle {
+				_ = c.send(sccp.NewIT(remoteRef, localRef, protocolClass, 0, recvCredit))
+			}
+		case <-c.closeCh:
+			return
+		}
+	}
+}
+
+func (c *Conn) handleRLSD(m *sccp.RLSD) {
+	c.mu.Lock()
+	c.state = stateClosed
+	localRef, remoteRef := c.localRef, c.remoteRef
+	c.mu.Unlock()
+
+	_ = c.send(sccp.NewRLC(remoteRef, localRef))
+	c.closeLocked()
+}
+
+func (c *Conn) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state == stateClosed
+}
+
+// Read reads reassembled user data from the connection.
+func (c *Conn) Read(p []byte) (int, error) {
+	if len(c.readBuf) == 0 {
+		select {
+		case data, ok := <-c.incoming:
+			if !ok {
+				return 0, io.EOF
+			}
+			c.readBuf = data
+		case err := <-c.errCh:
+			return 0, err
+		case <-c.closeCh:
+			return 0, ErrClosed
+		}
+	}
+
+	n := copy(p, c.readBuf)
+	c.readBuf = c.readBuf[n:]
+	return n, nil
+}
+
+// Write sends p as user data, segmenting it into DT1s (class 2) or DT2s
+// (class 3) as needed.
+func (c *Conn) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	if c.state != stateActive {
+		c.mu.Unlock()
+		return 0, ErrNotActive
+	}
+	remoteRef, protocolClass := c.remoteRef, c.protocolClass
+	c.mu.Unlock()
+
+	if protocolClass == 3 {
+		return c.writeClass3(remoteRef, p)
+	}
+	return c.writeClass2(remoteRef, p)
+}
+
+func (c *Conn) writeClass2(remoteRef, p []byte) (int, error) {
+	for _, seg := range sccp.NewSegmenter(0).Segment(remoteRef, p) {
+		if err := c.send(seg); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// writeClass3 sends p as a sequence of DT2 segments, consuming one unit of
+// sendCredit per segment. On error - including ErrWindowExhausted - it
+// returns the number of bytes actually sent rather than 0, since earlier
+// segments in this call may already be on the wire with sendSeq advanced; the
+// caller must resume from p[n:] rather than retry the whole buffer.
+func (c *Conn) writeClass3(remoteRef, p []byte) (int, error) {
+	var sent int
+	for _, seg := range sccp.NewSegmenter(0).Segment(remoteRef, p) {
+		c.mu.Lock()
+		if c.sendCredit == 0 {
+			c.mu.Unlock()
+			return sent, ErrWindowExhausted
+		}
+		c.sendSeq++
+		seqSeg := c.sendSeq << 1
+		if seg.SegmentingReassembling&0x01 != 0 {
+			seqSeg |= 1
+		}
+		c.sendCredit--
+		c.mu.Unlock()
+
+		if err := c.send(sccp.NewDT2(remoteRef, seqSeg, seg.Data)); err != nil {
+			return sent, err
+		}
+		sent += len(seg.Data)
+	}
+	return sent, nil
+}
+
+// Close releases the connection, sending an RLSD and waiting for the peer's
+// RLC if the connection was active.
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		wasActive := c.state == stateActive
+		localRef, remoteRef := c.localRef, c.remoteRef
+		c.state = stateDisconnPending
+		c.mu.Unlock()
+
+		if wasActive {
+			if sendErr := c.send(sccp.NewRLSD(remoteRef, localRef, 0)); sendErr != nil {
+				err = sendErr
+			} else {
+				select {
+				case <-c.rlcCh:
+				case <-time.After(DefaultInactivityTimeout):
+				}
+			}
+		}
+
+		c.closeLocked()
+	})
+	return err
+}
+
+func (c *Conn) closeLocked() {
+	c.mu.Lock()
+	c.state = stateClosed
+	c.mu.Unlock()
+
+	select {
+	case <-c.closeCh:
+	default:
+		close(c.closeCh)
+	}
+}
+
+func (c *Conn) send(m sccp.Message) error {
+	b, err := m.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = c.transport.Write(b)
+	if err == nil {
+		c.mu.Lock()
+		c.lastActivity = time.Now()
+		c.mu.Unlock()
+	}
+	return err
+}
+
+func readMessage(t Transport) (sccp.Message, error) {
+	buf := make([]byte, 4096)
+	n, err := t.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return sccp.ParseMessage(buf[:n])
+}
@@ -0,0 +1,121 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package conn
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stuchl4n3k/go-sccp"
+)
+
+// pipeTransport is a Transport backed by an io.Pipe, used to connect two
+// in-process Conns without a real network.
+type pipeTransport struct {
+	r *io.PipeReader
+	w *io.PipeWriter
+}
+
+func (p *pipeTransport) Read(b []byte) (int, error)  { return p.r.Read(b) }
+func (p *pipeTransport) Write(b []byte) (int, error) { return p.w.Write(b) }
+
+// newPipeTransports returns a pair of Transports connected to each other:
+// writes on one are read on the other, and vice versa.
+func newPipeTransports() (a, b Transport) {
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+	return &pipeTransport{r: ar, w: bw}, &pipeTransport{r: br, w: aw}
+}
+
+func dialAndAccept(t *testing.T, protocolClass uint8) (client, server *Conn) {
+	t.Helper()
+
+	clientTransport, serverTransport := newPipeTransports()
+
+	type acceptResult struct {
+		conn *Conn
+		err  error
+	}
+	acceptCh := make(chan acceptResult, 1)
+	go func() {
+		c, err := Listen(serverTransport, protocolClass).Accept()
+		acceptCh <- acceptResult{c, err}
+	}()
+
+	client, err := Dial(clientTransport, protocolClass, sccp.NewPartyAddressSSN(8), sccp.NewPartyAddressSSN(6))
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+
+	res := <-acceptCh
+	if res.err != nil {
+		t.Fatalf("Accept: %v", res.err)
+	}
+
+	return client, res.conn
+}
+
+func TestDialAccept(t *testing.T) {
+	client, server := dialAndAccept(t, 2)
+	defer client.Close()
+	defer server.Close()
+
+	if client.state != stateActive {
+		t.Errorf("client state = %v, want stateActive", client.state)
+	}
+	if server.state != stateActive {
+		t.Errorf("server state = %v, want stateActive", server.state)
+	}
+	if !bytes.Equal(client.remoteRef, server.localRef) {
+		t.Errorf("client.remoteRef = %v, want %v", client.remoteRef, server.localRef)
+	}
+	if !bytes.Equal(server.remoteRef, client.localRef) {
+		t.Errorf("server.remoteRef = %v, want %v", server.remoteRef, client.localRef)
+	}
+}
+
+func TestClass2SegmentedWriteRead(t *testing.T) {
+	client, server := dialAndAccept(t, 2)
+	defer client.Close()
+	defer server.Close()
+
+	payload := bytes.Repeat([]byte("0123456789"), 40) // 400 bytes, spans several DT1s
+	n, err := client.Write(payload)
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if n != len(payload) {
+		t.Fatalf("Write = %d, want %d", n, len(payload))
+	}
+
+	got := make([]byte, 0, len(payload))
+	buf := make([]byte, 4096)
+	for len(got) < len(payload) {
+		n, err := server.Read(buf)
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		got = append(got, buf[:n]...)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Errorf("got %d bytes, want payload of %d bytes", len(got), len(payload))
+	}
+}
+
+func TestCloseReleasesBothEnds(t *testing.T) {
+	client, server := dialAndAccept(t, 2)
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if _, err := client.Read(make([]byte, 1)); err != ErrClosed {
+		t.Errorf("client.Read after Close: err = %v, want ErrClosed", err)
+	}
+	if _, err := server.Read(make([]byte, 1)); err == nil {
+		t.Errorf("server.Read after peer Close: got nil error, want failure")
+	}
+}
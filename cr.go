@@ -0,0 +1,197 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// CR represents a SCCP Connection Request message.
+type CR struct {
+	Type               MsgType
+	SourceLocalRef     []byte
+	ProtocolClass      uint8
+	Ptr1               uint8 // pointer to CalledPartyAddress
+	Ptr2               uint8 // pointer to optional part, 0 if absent
+	CalledPartyAddress *PartyAddress
+	OptionalParameters []OptionalParameter
+}
+
+// NewCR creates a new CR. callingPartyAddress is optional and may be nil, in
+// which case no Calling Party Address optional parameter is attached.
+func NewCR(sourceLocalRef []byte, protocolClass uint8, calledPartyAddress, callingPartyAddress *PartyAddress) *CR {
+	c := &CR{
+		Type:               MsgTypeCR,
+		SourceLocalRef:     sourceLocalRef,
+		ProtocolClass:      protocolClass,
+		CalledPartyAddress: calledPartyAddress,
+	}
+	if callingPartyAddress != nil {
+		addr, _ := callingPartyAddress.MarshalBinary()
+		c.OptionalParameters = []OptionalParameter{
+			{Name: ParamCallingPartyAddress, Length: uint8(len(addr)), Value: addr},
+		}
+	}
+	c.updatePointers()
+
+	return c
+}
+
+// updatePointers recomputes Ptr1 and Ptr2 from the current field contents.
+func (c *CR) updatePointers() {
+	offsetCalled := 7
+	c.Ptr1 = uint8(offsetCalled - 5)
+
+	if len(c.OptionalParameters) == 0 {
+		c.Ptr2 = 0
+		return
+	}
+
+	offsetOptional := offsetCalled + 1 + c.CalledPartyAddress.MarshalLen()
+	c.Ptr2 = uint8(offsetOptional - 6)
+}
+
+// CallingPartyAddress returns the Calling Party Address optional parameter,
+// if present.
+func (c *CR) CallingPartyAddress() (*PartyAddress, bool) {
+	p, ok := findOptionalParameter(c.OptionalParameters, ParamCallingPartyAddress)
+	if !ok {
+		return nil, false
+	}
+
+	addr := &PartyAddress{}
+	if err := addr.UnmarshalBinary(p.Value); err != nil {
+		return nil, false
+	}
+	return addr, true
+}
+
+// MarshalBinary returns the byte sequence generated from a CR instance.
+func (c *CR) MarshalBinary() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (c *CR) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(c.Type)
+	copy(b[1:4], c.SourceLocalRef)
+	b[4] = c.ProtocolClass
+	b[5] = c.Ptr1
+	b[6] = c.Ptr2
+
+	addrLen := c.CalledPartyAddress.MarshalLen()
+	offset := int(c.Ptr1) + 5
+	if l < offset+1+addrLen {
+		return io.ErrUnexpectedEOF
+	}
+	b[offset] = uint8(addrLen)
+	if err := c.CalledPartyAddress.MarshalTo(b[offset+1 : offset+1+addrLen]); err != nil {
+		return err
+	}
+
+	if c.Ptr2 != 0 {
+		optOffset := int(c.Ptr2) + 6
+		opt := MarshalOptionalParameters(c.OptionalParameters)
+		if l < optOffset+len(opt) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[optOffset:], opt)
+	}
+
+	return nil
+}
+
+// ParseCR decodes given byte sequence as a SCCP CR.
+func ParseCR(b []byte) (*CR, error) {
+	c := &CR{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP CR.
+func (c *CR) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	c.Type = MsgType(b[0])
+	c.SourceLocalRef = b[1:4]
+	c.ProtocolClass = b[4]
+	c.Ptr1 = b[5]
+	c.Ptr2 = b[6]
+
+	offset := int(c.Ptr1) + 5
+	if l <= offset {
+		return io.ErrUnexpectedEOF
+	}
+	addrLen := int(b[offset])
+	if l < offset+1+addrLen {
+		return io.ErrUnexpectedEOF
+	}
+	c.CalledPartyAddress = &PartyAddress{}
+	if err := c.CalledPartyAddress.UnmarshalBinary(b[offset+1 : offset+1+addrLen]); err != nil {
+		return err
+	}
+
+	if c.Ptr2 != 0 {
+		optOffset := int(c.Ptr2) + 6
+		if l < optOffset {
+			return io.ErrUnexpectedEOF
+		}
+		params, err := ParseOptionalParameters(b[optOffset:])
+		if err != nil {
+			return err
+		}
+		c.OptionalParameters = params
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (c *CR) MarshalLen() int {
+	l := 7 + 1 + c.CalledPartyAddress.MarshalLen()
+	if len(c.OptionalParameters) > 0 {
+		l += len(MarshalOptionalParameters(c.OptionalParameters))
+	}
+
+	return l
+}
+
+// String returns the CR values in human readable format.
+func (c *CR) String() string {
+	return fmt.Sprintf("{Type: %d, SourceLocalRef: %v, ProtocolClass: %d, CalledPartyAddress: %s, OptionalParameters: %v}",
+		c.Type,
+		c.SourceLocalRef,
+		c.ProtocolClass,
+		c.CalledPartyAddress,
+		c.OptionalParameters,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (c *CR) MessageType() MsgType {
+	return MsgTypeCR
+}
+
+// MessageTypeName returns the Message Type in string.
+func (c *CR) MessageTypeName() string {
+	return "CR"
+}
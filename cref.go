@@ -0,0 +1,119 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// CREF represents a SCCP Connection Refused message.
+type CREF struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	RefusalCause        uint8
+	Ptr1                uint8 // pointer to optional part, 0 if absent
+	OptionalPart        []byte
+}
+
+// NewCREF creates a new CREF.
+func NewCREF(destinationLocalRef []byte, refusalCause uint8) *CREF {
+	return &CREF{
+		Type:                MsgTypeCREF,
+		DestinationLocalRef: destinationLocalRef,
+		RefusalCause:        refusalCause,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a CREF instance.
+func (c *CREF) MarshalBinary() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (c *CREF) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 6 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(c.Type)
+	copy(b[1:4], c.DestinationLocalRef)
+	b[4] = c.RefusalCause
+	b[5] = c.Ptr1
+
+	if c.Ptr1 != 0 {
+		offset := int(c.Ptr1) + 5
+		if l < offset+len(c.OptionalPart) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[offset:], c.OptionalPart)
+	}
+
+	return nil
+}
+
+// ParseCREF decodes given byte sequence as a SCCP CREF.
+func ParseCREF(b []byte) (*CREF, error) {
+	c := &CREF{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP CREF.
+func (c *CREF) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 6 {
+		return io.ErrUnexpectedEOF
+	}
+
+	c.Type = MsgType(b[0])
+	c.DestinationLocalRef = b[1:4]
+	c.RefusalCause = b[4]
+	c.Ptr1 = b[5]
+
+	if c.Ptr1 != 0 {
+		offset := int(c.Ptr1) + 5
+		if l < offset {
+			return io.ErrUnexpectedEOF
+		}
+		c.OptionalPart = b[offset:]
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (c *CREF) MarshalLen() int {
+	return 6 + len(c.OptionalPart)
+}
+
+// String returns the CREF values in human readable format.
+func (c *CREF) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, RefusalCause: %d, OptionalPart: %x}",
+		c.Type,
+		c.DestinationLocalRef,
+		c.RefusalCause,
+		c.OptionalPart,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (c *CREF) MessageType() MsgType {
+	return MsgTypeCREF
+}
+
+// MessageTypeName returns the Message Type in string.
+func (c *CREF) MessageTypeName() string {
+	return "CREF"
+}
@@ -0,0 +1,148 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// DT2 represents a SCCP Message Data form 2 (DT2), used on protocol class 3
+// connections where segmenting/reassembling also carries the send sequence
+// number P(S).
+type DT2 struct {
+	Type                 MsgType
+	DestinationLocalRef  []byte
+	SequencingSegmenting uint8
+	Ptr1                 uint8
+	DataLength           uint8
+	Data                 []byte
+}
+
+// NewDT2 creates a new DT2.
+func NewDT2(destinationLocalRef []byte, sequencingSegmenting uint8, data []byte) *DT2 {
+	d := &DT2{
+		Type:                 MsgTypeDT2,
+		DestinationLocalRef:  destinationLocalRef,
+		SequencingSegmenting: sequencingSegmenting,
+		Ptr1:                 1,
+		Data:                 data,
+	}
+	d.SetLength()
+
+	return d
+}
+
+// MarshalBinary returns the byte sequence generated from a DT2 instance.
+func (d *DT2) MarshalBinary() ([]byte, error) {
+	b := make([]byte, d.MarshalLen())
+	if err := d.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (d *DT2) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(d.Type)
+	copy(b[1:4], d.DestinationLocalRef)
+	b[4] = d.SequencingSegmenting
+	b[5] = d.Ptr1
+	if l < int(d.Ptr1) {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[d.Ptr1+5] = d.DataLength
+
+	if offset := int(d.Ptr1 + 6); len(b[offset:]) >= int(d.DataLength) {
+		copy(b[offset:], d.Data)
+		return nil
+	}
+
+	return io.ErrUnexpectedEOF
+}
+
+// ParseDT2 decodes given byte sequence as a SCCP DT2.
+func ParseDT2(b []byte) (*DT2, error) {
+	d := &DT2{}
+	if err := d.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP DT2.
+func (d *DT2) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l <= 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	d.Type = MsgType(b[0])
+	d.DestinationLocalRef = b[1:4]
+	d.SequencingSegmenting = b[4]
+	d.Ptr1 = b[5]
+	if l < int(d.Ptr1) {
+		return io.ErrUnexpectedEOF
+	}
+
+	d.DataLength = b[int(d.Ptr1+5)]
+	if offset, dataLen := int(d.Ptr1+6), int(d.DataLength); l >= offset+dataLen {
+		d.Data = b[offset : offset+dataLen]
+		return nil
+	}
+
+	return io.ErrUnexpectedEOF
+}
+
+// MarshalLen returns the serial length.
+func (d *DT2) MarshalLen() int {
+	return 7 + len(d.Data)
+}
+
+// SetLength sets the length in Length field.
+func (d *DT2) SetLength() {
+	d.DataLength = uint8(len(d.Data))
+}
+
+// SendSequenceNumber returns P(S), the send sequence number carried in the
+// upper 7 bits of SequencingSegmenting.
+func (d *DT2) SendSequenceNumber() uint8 {
+	return d.SequencingSegmenting >> 1
+}
+
+// MoreData reports whether the "more data" bit is set, i.e. this DT2 is one
+// segment of a larger SDU and is not the last one.
+func (d *DT2) MoreData() bool {
+	return d.SequencingSegmenting&0x01 != 0
+}
+
+// String returns the DT2 values in human readable format.
+func (d *DT2) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, SequencingSegmenting: %v, DataLength: %d, Data: %x}",
+		d.Type,
+		d.DestinationLocalRef,
+		d.SequencingSegmenting,
+		d.DataLength,
+		d.Data,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (d *DT2) MessageType() MsgType {
+	return MsgTypeDT2
+}
+
+// MessageTypeName returns the Message Type in string.
+func (d *DT2) MessageTypeName() string {
+	return "DT2"
+}
@@ -0,0 +1,88 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// EA represents a SCCP Expedited Data Acknowledgement message.
+type EA struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+}
+
+// NewEA creates a new EA.
+func NewEA(destinationLocalRef []byte) *EA {
+	return &EA{
+		Type:                MsgTypeEA,
+		DestinationLocalRef: destinationLocalRef,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a EA instance.
+func (e *EA) MarshalBinary() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *EA) MarshalTo(b []byte) error {
+	if len(b) < 4 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(e.Type)
+	copy(b[1:4], e.DestinationLocalRef)
+
+	return nil
+}
+
+// ParseEA decodes given byte sequence as a SCCP EA.
+func ParseEA(b []byte) (*EA, error) {
+	e := &EA{}
+	if err := e.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP EA.
+func (e *EA) UnmarshalBinary(b []byte) error {
+	if len(b) < 4 {
+		return io.ErrUnexpectedEOF
+	}
+
+	e.Type = MsgType(b[0])
+	e.DestinationLocalRef = b[1:4]
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (e *EA) MarshalLen() int {
+	return 4
+}
+
+// String returns the EA values in human readable format.
+func (e *EA) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v}", e.Type, e.DestinationLocalRef)
+}
+
+// MessageType returns the Message Type in int.
+func (e *EA) MessageType() MsgType {
+	return MsgTypeEA
+}
+
+// MessageTypeName returns the Message Type in string.
+func (e *EA) MessageTypeName() string {
+	return "EA"
+}
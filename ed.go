@@ -0,0 +1,129 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// ED represents a SCCP Expedited Data message.
+type ED struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	Ptr1                uint8
+	DataLength          uint8
+	Data                []byte
+}
+
+// NewED creates a new ED.
+func NewED(destinationLocalRef []byte, data []byte) *ED {
+	e := &ED{
+		Type:                MsgTypeED,
+		DestinationLocalRef: destinationLocalRef,
+		Ptr1:                1,
+		Data:                data,
+	}
+	e.SetLength()
+
+	return e
+}
+
+// MarshalBinary returns the byte sequence generated from a ED instance.
+func (e *ED) MarshalBinary() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *ED) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 6 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(e.Type)
+	copy(b[1:4], e.DestinationLocalRef)
+	b[4] = e.Ptr1
+	if l < int(e.Ptr1) {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[e.Ptr1+4] = e.DataLength
+
+	if offset := int(e.Ptr1 + 5); len(b[offset:]) >= int(e.DataLength) {
+		copy(b[offset:], e.Data)
+		return nil
+	}
+
+	return io.ErrUnexpectedEOF
+}
+
+// ParseED decodes given byte sequence as a SCCP ED.
+func ParseED(b []byte) (*ED, error) {
+	e := &ED{}
+	if err := e.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP ED.
+func (e *ED) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l <= 6 {
+		return io.ErrUnexpectedEOF
+	}
+
+	e.Type = MsgType(b[0])
+	e.DestinationLocalRef = b[1:4]
+	e.Ptr1 = b[4]
+	if l < int(e.Ptr1) {
+		return io.ErrUnexpectedEOF
+	}
+
+	e.DataLength = b[int(e.Ptr1+4)]
+	if offset, dataLen := int(e.Ptr1+5), int(e.DataLength); l >= offset+dataLen {
+		e.Data = b[offset : offset+dataLen]
+		return nil
+	}
+
+	return io.ErrUnexpectedEOF
+}
+
+// MarshalLen returns the serial length.
+func (e *ED) MarshalLen() int {
+	return 6 + len(e.Data)
+}
+
+// SetLength sets the length in Length field.
+func (e *ED) SetLength() {
+	e.DataLength = uint8(len(e.Data))
+}
+
+// String returns the ED values in human readable format.
+func (e *ED) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, DataLength: %d, Data: %x}",
+		e.Type,
+		e.DestinationLocalRef,
+		e.DataLength,
+		e.Data,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (e *ED) MessageType() MsgType {
+	return MsgTypeED
+}
+
+// MessageTypeName returns the Message Type in string.
+func (e *ED) MessageTypeName() string {
+	return "ED"
+}
@@ -0,0 +1,96 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// ERR represents a SCCP Protocol Data Unit Error message.
+type ERR struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	ErrorCause          uint8
+}
+
+// NewERR creates a new ERR.
+func NewERR(destinationLocalRef []byte, errorCause uint8) *ERR {
+	return &ERR{
+		Type:                MsgTypeERR,
+		DestinationLocalRef: destinationLocalRef,
+		ErrorCause:          errorCause,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a ERR instance.
+func (e *ERR) MarshalBinary() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *ERR) MarshalTo(b []byte) error {
+	if len(b) < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(e.Type)
+	copy(b[1:4], e.DestinationLocalRef)
+	b[4] = e.ErrorCause
+
+	return nil
+}
+
+// ParseERR decodes given byte sequence as a SCCP ERR.
+func ParseERR(b []byte) (*ERR, error) {
+	e := &ERR{}
+	if err := e.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP ERR.
+func (e *ERR) UnmarshalBinary(b []byte) error {
+	if len(b) < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	e.Type = MsgType(b[0])
+	e.DestinationLocalRef = b[1:4]
+	e.ErrorCause = b[4]
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (e *ERR) MarshalLen() int {
+	return 5
+}
+
+// String returns the ERR values in human readable format.
+func (e *ERR) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, ErrorCause: %d}",
+		e.Type,
+		e.DestinationLocalRef,
+		e.ErrorCause,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (e *ERR) MessageType() MsgType {
+	return MsgTypeERR
+}
+
+// MessageTypeName returns the Message Type in string.
+func (e *ERR) MessageTypeName() string {
+	return "ERR"
+}
@@ -0,0 +1,113 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// IT represents a SCCP Inactivity Test message, sent periodically on an
+// established connection to let both ends verify that the other side still
+// considers it active.
+type IT struct {
+	Type                 MsgType
+	DestinationLocalRef  []byte
+	SourceLocalRef       []byte
+	ProtocolClass        uint8
+	SequencingSegmenting uint8
+	Credit               uint8
+}
+
+// NewIT creates a new IT.
+func NewIT(destinationLocalRef, sourceLocalRef []byte, protocolClass, sequencingSegmenting, credit uint8) *IT {
+	return &IT{
+		Type:                 MsgTypeIT,
+		DestinationLocalRef:  destinationLocalRef,
+		SourceLocalRef:       sourceLocalRef,
+		ProtocolClass:        protocolClass,
+		SequencingSegmenting: sequencingSegmenting,
+		Credit:               credit,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a IT instance.
+func (i *IT) MarshalBinary() ([]byte, error) {
+	b := make([]byte, i.MarshalLen())
+	if err := i.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (i *IT) MarshalTo(b []byte) error {
+	if len(b) < 10 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(i.Type)
+	copy(b[1:4], i.DestinationLocalRef)
+	copy(b[4:7], i.SourceLocalRef)
+	b[7] = i.ProtocolClass
+	b[8] = i.SequencingSegmenting
+	b[9] = i.Credit
+
+	return nil
+}
+
+// ParseIT decodes given byte sequence as a SCCP IT.
+func ParseIT(b []byte) (*IT, error) {
+	i := &IT{}
+	if err := i.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP IT.
+func (i *IT) UnmarshalBinary(b []byte) error {
+	if len(b) < 10 {
+		return io.ErrUnexpectedEOF
+	}
+
+	i.Type = MsgType(b[0])
+	i.DestinationLocalRef = b[1:4]
+	i.SourceLocalRef = b[4:7]
+	i.ProtocolClass = b[7]
+	i.SequencingSegmenting = b[8]
+	i.Credit = b[9]
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (i *IT) MarshalLen() int {
+	return 10
+}
+
+// String returns the IT values in human readable format.
+func (i *IT) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, SourceLocalRef: %v, ProtocolClass: %d, SequencingSegmenting: %v, Credit: %d}",
+		i.Type,
+		i.DestinationLocalRef,
+		i.SourceLocalRef,
+		i.ProtocolClass,
+		i.SequencingSegmenting,
+		i.Credit,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (i *IT) MessageType() MsgType {
+	return MsgTypeIT
+}
+
+// MessageTypeName returns the Message Type in string.
+func (i *IT) MessageTypeName() string {
+	return "IT"
+}
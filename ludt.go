@@ -0,0 +1,258 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// MaxLUDTDataLength is the largest Data payload a single LUDT/LUDTS can
+// carry, imposed by the 16-bit length field's practical SCCP ceiling.
+const MaxLUDTDataLength = 3952
+
+// LUDT represents a SCCP Long Unitdata message: like XUDT, but with 16-bit
+// pointers and length fields so it can carry up to MaxLUDTDataLength octets
+// of data in a single segment.
+type LUDT struct {
+	Type                MsgType
+	ProtocolClass       uint8
+	HopCounter          uint8
+	Ptr1                uint16 // -> CalledPartyAddress
+	Ptr2                uint16 // -> CallingPartyAddress
+	Ptr3                uint16 // -> Data
+	Ptr4                uint16 // -> optional part, 0 if absent
+	CalledPartyAddress  *PartyAddress
+	CallingPartyAddress *PartyAddress
+	Data                []byte
+	OptionalParameters  []OptionalParameter
+}
+
+// NewLUDT creates a new LUDT.
+func NewLUDT(protocolClass, hopCounter uint8, calledPartyAddress, callingPartyAddress *PartyAddress, data []byte, optionalParameters []OptionalParameter) *LUDT {
+	l := &LUDT{
+		Type:                MsgTypeLUDT,
+		ProtocolClass:       protocolClass,
+		HopCounter:          hopCounter,
+		CalledPartyAddress:  calledPartyAddress,
+		CallingPartyAddress: callingPartyAddress,
+		Data:                data,
+		OptionalParameters:  optionalParameters,
+	}
+	l.updatePointers()
+
+	return l
+}
+
+// ludtFixedPartLen is the length, in octets, of the fixed part plus the four
+// 16-bit pointers: Type(1) + ProtocolClass(1) + HopCounter(1) + 4*Ptr(2).
+const ludtFixedPartLen = 11
+
+func (l *LUDT) updatePointers() {
+	offsetCalled := ludtFixedPartLen
+	l.Ptr1 = uint16(offsetCalled - 3)
+
+	offsetCalling := offsetCalled + 2 + l.CalledPartyAddress.MarshalLen()
+	l.Ptr2 = uint16(offsetCalling - 5)
+
+	offsetData := offsetCalling + 2 + l.CallingPartyAddress.MarshalLen()
+	l.Ptr3 = uint16(offsetData - 7)
+
+	if len(l.OptionalParameters) == 0 {
+		l.Ptr4 = 0
+		return
+	}
+
+	offsetOptional := offsetData + 2 + len(l.Data)
+	l.Ptr4 = uint16(offsetOptional - 9)
+}
+
+// Segmentation returns the Segmentation optional parameter, if present.
+func (l *LUDT) Segmentation() (*Segmentation, bool) {
+	p, ok := findOptionalParameter(l.OptionalParameters, ParamSegmentation)
+	if !ok {
+		return nil, false
+	}
+
+	seg := &Segmentation{}
+	if err := seg.UnmarshalBinary(p.Value); err != nil {
+		return nil, false
+	}
+	return seg, true
+}
+
+// MarshalBinary returns the byte sequence generated from a LUDT instance.
+func (l *LUDT) MarshalBinary() ([]byte, error) {
+	b := make([]byte, l.MarshalLen())
+	if err := l.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (l *LUDT) MarshalTo(b []byte) error {
+	bl := len(b)
+	if bl < ludtFixedPartLen {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(l.Type)
+	b[1] = l.ProtocolClass
+	b[2] = l.HopCounter
+	binary.LittleEndian.PutUint16(b[3:5], l.Ptr1)
+	binary.LittleEndian.PutUint16(b[5:7], l.Ptr2)
+	binary.LittleEndian.PutUint16(b[7:9], l.Ptr3)
+	binary.LittleEndian.PutUint16(b[9:11], l.Ptr4)
+
+	calledLen := l.CalledPartyAddress.MarshalLen()
+	calledOffset := int(l.Ptr1) + 3
+	if bl < calledOffset+2+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	binary.LittleEndian.PutUint16(b[calledOffset:calledOffset+2], uint16(calledLen))
+	if err := l.CalledPartyAddress.MarshalTo(b[calledOffset+2 : calledOffset+2+calledLen]); err != nil {
+		return err
+	}
+
+	callingLen := l.CallingPartyAddress.MarshalLen()
+	callingOffset := int(l.Ptr2) + 5
+	if bl < callingOffset+2+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	binary.LittleEndian.PutUint16(b[callingOffset:callingOffset+2], uint16(callingLen))
+	if err := l.CallingPartyAddress.MarshalTo(b[callingOffset+2 : callingOffset+2+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(l.Ptr3) + 7
+	if bl < dataOffset+2+len(l.Data) {
+		return io.ErrUnexpectedEOF
+	}
+	binary.LittleEndian.PutUint16(b[dataOffset:dataOffset+2], uint16(len(l.Data)))
+	copy(b[dataOffset+2:], l.Data)
+
+	if l.Ptr4 != 0 {
+		optOffset := int(l.Ptr4) + 9
+		opt := MarshalOptionalParameters(l.OptionalParameters)
+		if bl < optOffset+len(opt) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[optOffset:], opt)
+	}
+
+	return nil
+}
+
+// ParseLUDT decodes given byte sequence as a SCCP LUDT.
+func ParseLUDT(b []byte) (*LUDT, error) {
+	l := &LUDT{}
+	if err := l.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP LUDT.
+func (l *LUDT) UnmarshalBinary(b []byte) error {
+	bl := len(b)
+	if bl < ludtFixedPartLen {
+		return io.ErrUnexpectedEOF
+	}
+
+	l.Type = MsgType(b[0])
+	l.ProtocolClass = b[1]
+	l.HopCounter = b[2]
+	l.Ptr1 = binary.LittleEndian.Uint16(b[3:5])
+	l.Ptr2 = binary.LittleEndian.Uint16(b[5:7])
+	l.Ptr3 = binary.LittleEndian.Uint16(b[7:9])
+	l.Ptr4 = binary.LittleEndian.Uint16(b[9:11])
+
+	calledOffset := int(l.Ptr1) + 3
+	if bl < calledOffset+2 {
+		return io.ErrUnexpectedEOF
+	}
+	calledLen := int(binary.LittleEndian.Uint16(b[calledOffset : calledOffset+2]))
+	if bl < calledOffset+2+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	l.CalledPartyAddress = &PartyAddress{}
+	if err := l.CalledPartyAddress.UnmarshalBinary(b[calledOffset+2 : calledOffset+2+calledLen]); err != nil {
+		return err
+	}
+
+	callingOffset := int(l.Ptr2) + 5
+	if bl < callingOffset+2 {
+		return io.ErrUnexpectedEOF
+	}
+	callingLen := int(binary.LittleEndian.Uint16(b[callingOffset : callingOffset+2]))
+	if bl < callingOffset+2+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	l.CallingPartyAddress = &PartyAddress{}
+	if err := l.CallingPartyAddress.UnmarshalBinary(b[callingOffset+2 : callingOffset+2+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(l.Ptr3) + 7
+	if bl < dataOffset+2 {
+		return io.ErrUnexpectedEOF
+	}
+	dataLen := int(binary.LittleEndian.Uint16(b[dataOffset : dataOffset+2]))
+	if bl < dataOffset+2+dataLen {
+		return io.ErrUnexpectedEOF
+	}
+	l.Data = b[dataOffset+2 : dataOffset+2+dataLen]
+
+	if l.Ptr4 != 0 {
+		optOffset := int(l.Ptr4) + 9
+		if bl < optOffset {
+			return io.ErrUnexpectedEOF
+		}
+		params, err := ParseOptionalParameters(b[optOffset:])
+		if err != nil {
+			return err
+		}
+		l.OptionalParameters = params
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (l *LUDT) MarshalLen() int {
+	ln := ludtFixedPartLen + 2 + l.CalledPartyAddress.MarshalLen() + 2 + l.CallingPartyAddress.MarshalLen() + 2 + len(l.Data)
+	if len(l.OptionalParameters) > 0 {
+		ln += len(MarshalOptionalParameters(l.OptionalParameters))
+	}
+
+	return ln
+}
+
+// String returns the LUDT values in human readable format.
+func (l *LUDT) String() string {
+	return fmt.Sprintf("{Type: %d, ProtocolClass: %d, HopCounter: %d, CalledPartyAddress: %s, CallingPartyAddress: %s, Data: %x, OptionalParameters: %v}",
+		l.Type,
+		l.ProtocolClass,
+		l.HopCounter,
+		l.CalledPartyAddress,
+		l.CallingPartyAddress,
+		l.Data,
+		l.OptionalParameters,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (l *LUDT) MessageType() MsgType {
+	return MsgTypeLUDT
+}
+
+// MessageTypeName returns the Message Type in string.
+func (l *LUDT) MessageTypeName() string {
+	return "LUDT"
+}
@@ -0,0 +1,235 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// LUDTS represents a SCCP Long Unitdata Service message, returned to the
+// originator when a LUDT could not be delivered (see ReturnCause).
+type LUDTS struct {
+	Type                MsgType
+	ReturnCause         uint8
+	HopCounter          uint8
+	Ptr1                uint16 // -> CalledPartyAddress
+	Ptr2                uint16 // -> CallingPartyAddress
+	Ptr3                uint16 // -> Data
+	Ptr4                uint16 // -> optional part, 0 if absent
+	CalledPartyAddress  *PartyAddress
+	CallingPartyAddress *PartyAddress
+	Data                []byte
+	OptionalParameters  []OptionalParameter
+}
+
+// NewLUDTS creates a new LUDTS.
+func NewLUDTS(returnCause, hopCounter uint8, calledPartyAddress, callingPartyAddress *PartyAddress, data []byte, optionalParameters []OptionalParameter) *LUDTS {
+	l := &LUDTS{
+		Type:                MsgTypeLUDTS,
+		ReturnCause:         returnCause,
+		HopCounter:          hopCounter,
+		CalledPartyAddress:  calledPartyAddress,
+		CallingPartyAddress: callingPartyAddress,
+		Data:                data,
+		OptionalParameters:  optionalParameters,
+	}
+	l.updatePointers()
+
+	return l
+}
+
+func (l *LUDTS) updatePointers() {
+	offsetCalled := ludtFixedPartLen
+	l.Ptr1 = uint16(offsetCalled - 3)
+
+	offsetCalling := offsetCalled + 2 + l.CalledPartyAddress.MarshalLen()
+	l.Ptr2 = uint16(offsetCalling - 5)
+
+	offsetData := offsetCalling + 2 + l.CallingPartyAddress.MarshalLen()
+	l.Ptr3 = uint16(offsetData - 7)
+
+	if len(l.OptionalParameters) == 0 {
+		l.Ptr4 = 0
+		return
+	}
+
+	offsetOptional := offsetData + 2 + len(l.Data)
+	l.Ptr4 = uint16(offsetOptional - 9)
+}
+
+// MarshalBinary returns the byte sequence generated from a LUDTS instance.
+func (l *LUDTS) MarshalBinary() ([]byte, error) {
+	b := make([]byte, l.MarshalLen())
+	if err := l.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (l *LUDTS) MarshalTo(b []byte) error {
+	bl := len(b)
+	if bl < ludtFixedPartLen {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(l.Type)
+	b[1] = l.ReturnCause
+	b[2] = l.HopCounter
+	binary.LittleEndian.PutUint16(b[3:5], l.Ptr1)
+	binary.LittleEndian.PutUint16(b[5:7], l.Ptr2)
+	binary.LittleEndian.PutUint16(b[7:9], l.Ptr3)
+	binary.LittleEndian.PutUint16(b[9:11], l.Ptr4)
+
+	calledLen := l.CalledPartyAddress.MarshalLen()
+	calledOffset := int(l.Ptr1) + 3
+	if bl < calledOffset+2+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	binary.LittleEndian.PutUint16(b[calledOffset:calledOffset+2], uint16(calledLen))
+	if err := l.CalledPartyAddress.MarshalTo(b[calledOffset+2 : calledOffset+2+calledLen]); err != nil {
+		return err
+	}
+
+	callingLen := l.CallingPartyAddress.MarshalLen()
+	callingOffset := int(l.Ptr2) + 5
+	if bl < callingOffset+2+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	binary.LittleEndian.PutUint16(b[callingOffset:callingOffset+2], uint16(callingLen))
+	if err := l.CallingPartyAddress.MarshalTo(b[callingOffset+2 : callingOffset+2+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(l.Ptr3) + 7
+	if bl < dataOffset+2+len(l.Data) {
+		return io.ErrUnexpectedEOF
+	}
+	binary.LittleEndian.PutUint16(b[dataOffset:dataOffset+2], uint16(len(l.Data)))
+	copy(b[dataOffset+2:], l.Data)
+
+	if l.Ptr4 != 0 {
+		optOffset := int(l.Ptr4) + 9
+		opt := MarshalOptionalParameters(l.OptionalParameters)
+		if bl < optOffset+len(opt) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[optOffset:], opt)
+	}
+
+	return nil
+}
+
+// ParseLUDTS decodes given byte sequence as a SCCP LUDTS.
+func ParseLUDTS(b []byte) (*LUDTS, error) {
+	l := &LUDTS{}
+	if err := l.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP LUDTS.
+func (l *LUDTS) UnmarshalBinary(b []byte) error {
+	bl := len(b)
+	if bl < ludtFixedPartLen {
+		return io.ErrUnexpectedEOF
+	}
+
+	l.Type = MsgType(b[0])
+	l.ReturnCause = b[1]
+	l.HopCounter = b[2]
+	l.Ptr1 = binary.LittleEndian.Uint16(b[3:5])
+	l.Ptr2 = binary.LittleEndian.Uint16(b[5:7])
+	l.Ptr3 = binary.LittleEndian.Uint16(b[7:9])
+	l.Ptr4 = binary.LittleEndian.Uint16(b[9:11])
+
+	calledOffset := int(l.Ptr1) + 3
+	if bl < calledOffset+2 {
+		return io.ErrUnexpectedEOF
+	}
+	calledLen := int(binary.LittleEndian.Uint16(b[calledOffset : calledOffset+2]))
+	if bl < calledOffset+2+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	l.CalledPartyAddress = &PartyAddress{}
+	if err := l.CalledPartyAddress.UnmarshalBinary(b[calledOffset+2 : calledOffset+2+calledLen]); err != nil {
+		return err
+	}
+
+	callingOffset := int(l.Ptr2) + 5
+	if bl < callingOffset+2 {
+		return io.ErrUnexpectedEOF
+	}
+	callingLen := int(binary.LittleEndian.Uint16(b[callingOffset : callingOffset+2]))
+	if bl < callingOffset+2+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	l.CallingPartyAddress = &PartyAddress{}
+	if err := l.CallingPartyAddress.UnmarshalBinary(b[callingOffset+2 : callingOffset+2+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(l.Ptr3) + 7
+	if bl < dataOffset+2 {
+		return io.ErrUnexpectedEOF
+	}
+	dataLen := int(binary.LittleEndian.Uint16(b[dataOffset : dataOffset+2]))
+	if bl < dataOffset+2+dataLen {
+		return io.ErrUnexpectedEOF
+	}
+	l.Data = b[dataOffset+2 : dataOffset+2+dataLen]
+
+	if l.Ptr4 != 0 {
+		optOffset := int(l.Ptr4) + 9
+		if bl < optOffset {
+			return io.ErrUnexpectedEOF
+		}
+		params, err := ParseOptionalParameters(b[optOffset:])
+		if err != nil {
+			return err
+		}
+		l.OptionalParameters = params
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (l *LUDTS) MarshalLen() int {
+	ln := ludtFixedPartLen + 2 + l.CalledPartyAddress.MarshalLen() + 2 + l.CallingPartyAddress.MarshalLen() + 2 + len(l.Data)
+	if len(l.OptionalParameters) > 0 {
+		ln += len(MarshalOptionalParameters(l.OptionalParameters))
+	}
+
+	return ln
+}
+
+// String returns the LUDTS values in human readable format.
+func (l *LUDTS) String() string {
+	return fmt.Sprintf("{Type: %d, ReturnCause: %d, HopCounter: %d, CalledPartyAddress: %s, CallingPartyAddress: %s, Data: %x, OptionalParameters: %v}",
+		l.Type,
+		l.ReturnCause,
+		l.HopCounter,
+		l.CalledPartyAddress,
+		l.CallingPartyAddress,
+		l.Data,
+		l.OptionalParameters,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (l *LUDTS) MessageType() MsgType {
+	return MsgTypeLUDTS
+}
+
+// MessageTypeName returns the Message Type in string.
+func (l *LUDTS) MessageTypeName() string {
+	return "LUDTS"
+}
@@ -0,0 +1,133 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// Optional Parameter Name values (ITU-T Q.713 §3.*).
+const (
+	ParamEndOfOptionalParameters uint8 = 0x00
+	ParamCallingPartyAddress     uint8 = 0x04
+	ParamSegmentation            uint8 = 0x10
+	ParamImportance              uint8 = 0x12
+)
+
+// OptionalParameter is a single TLV entry of a message's optional part.
+type OptionalParameter struct {
+	Name   uint8
+	Length uint8
+	Value  []byte
+}
+
+// ParseOptionalParameters decodes a chain of OptionalParameter TLVs,
+// terminated by a ParamEndOfOptionalParameters (0x00) octet or the end of b.
+func ParseOptionalParameters(b []byte) ([]OptionalParameter, error) {
+	var params []OptionalParameter
+
+	offset := 0
+	for offset < len(b) {
+		name := b[offset]
+		if name == ParamEndOfOptionalParameters {
+			return params, nil
+		}
+		if offset+1 >= len(b) {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		length := b[offset+1]
+		if offset+2+int(length) > len(b) {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		params = append(params, OptionalParameter{
+			Name:   name,
+			Length: length,
+			Value:  b[offset+2 : offset+2+int(length)],
+		})
+		offset += 2 + int(length)
+	}
+
+	return params, nil
+}
+
+// MarshalOptionalParameters encodes params as a TLV chain terminated by
+// ParamEndOfOptionalParameters.
+func MarshalOptionalParameters(params []OptionalParameter) []byte {
+	l := 1
+	for _, p := range params {
+		l += 2 + len(p.Value)
+	}
+
+	b := make([]byte, l)
+	offset := 0
+	for _, p := range params {
+		b[offset] = p.Name
+		b[offset+1] = uint8(len(p.Value))
+		copy(b[offset+2:], p.Value)
+		offset += 2 + len(p.Value)
+	}
+	b[offset] = ParamEndOfOptionalParameters
+
+	return b
+}
+
+// findOptionalParameter returns the first parameter named name, if any.
+func findOptionalParameter(params []OptionalParameter, name uint8) (OptionalParameter, bool) {
+	for _, p := range params {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return OptionalParameter{}, false
+}
+
+// Segmentation is the typed form of the Segmentation optional parameter
+// (ITU-T Q.713 §3.17), carried by segmented XUDT/XUDTS/LUDT/LUDTS messages.
+type Segmentation struct {
+	// First reports whether this is the first segment of the SDU (F=1).
+	First bool
+	// Class is the protocol class the segmented message was sent with.
+	Class uint8
+	// RemainingSegments counts down to 0 on the last segment.
+	RemainingSegments uint8
+	// Reference identifies the SDU these segments belong to.
+	Reference [4]byte
+}
+
+// MarshalBinary returns the byte sequence generated from a Segmentation.
+func (s *Segmentation) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 5)
+	if s.First {
+		b[0] |= 0x80
+	}
+	b[0] |= (s.Class & 0x01) << 6
+	b[0] |= s.RemainingSegments & 0x3f
+	copy(b[1:5], s.Reference[:])
+
+	return b, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a Segmentation.
+func (s *Segmentation) UnmarshalBinary(b []byte) error {
+	if len(b) < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	s.First = b[0]&0x80 != 0
+	s.Class = (b[0] >> 6) & 0x01
+	s.RemainingSegments = b[0] & 0x3f
+	copy(s.Reference[:], b[1:5])
+
+	return nil
+}
+
+// String returns the Segmentation values in human readable format.
+func (s *Segmentation) String() string {
+	return fmt.Sprintf("{First: %v, Class: %d, RemainingSegments: %d, Reference: %x}",
+		s.First, s.Class, s.RemainingSegments, s.Reference)
+}
@@ -0,0 +1,414 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Global Title Indicator values (ITU-T Q.713 §3.4.2.3.1).
+const (
+	GTINoGlobalTitle                 uint8 = 0x00
+	GTINatureOfAddressOnly           uint8 = 0x01
+	GTITranslationTypeOnly           uint8 = 0x02
+	GTITranslationTypeNPEncScheme    uint8 = 0x03
+	GTITranslationTypeNPEncSchemeNAI uint8 = 0x04
+)
+
+// Encoding Scheme values carried by a GlobalTitle (ITU-T Q.713 §3.4.2.3.1.4).
+const (
+	EncodingSchemeBCDOdd  uint8 = 0x01
+	EncodingSchemeBCDEven uint8 = 0x02
+)
+
+// AddressIndicator is the first octet of a PartyAddress, describing which of
+// the remaining fields are present and how the address is to be routed.
+type AddressIndicator struct {
+	// NationalUse is the reserved/national-use bit (bit 8).
+	NationalUse bool
+	// RouteOnSSN reports whether routing uses SSN (true) rather than the
+	// Global Title (false) - the Routing Indicator bit (bit 7).
+	RouteOnSSN bool
+	// GTI is the Global Title Indicator (bits 6-3), selecting which
+	// GlobalTitle sub-fields are present.
+	GTI uint8
+	// HasSSN reports whether the Subsystem Number field is present.
+	HasSSN bool
+	// HasPC reports whether the Signalling Point Code field is present.
+	HasPC bool
+}
+
+func parseAddressIndicator(b byte) AddressIndicator {
+	return AddressIndicator{
+		NationalUse: b&0x80 != 0,
+		GTI:         (b >> 2) & 0x0f,
+		RouteOnSSN:  b&0x40 != 0,
+		HasSSN:      b&0x02 != 0,
+		HasPC:       b&0x01 != 0,
+	}
+}
+
+func (ai AddressIndicator) byte() byte {
+	var b byte
+	if ai.NationalUse {
+		b |= 0x80
+	}
+	b |= (ai.GTI & 0x0f) << 2
+	if ai.RouteOnSSN {
+		b |= 0x40
+	}
+	if ai.HasSSN {
+		b |= 0x02
+	}
+	if ai.HasPC {
+		b |= 0x01
+	}
+	return b
+}
+
+// PartyAddress is the typed form of a SCCP Called/Calling Party Address
+// parameter, replacing a hand-built octet string with named, validated
+// fields.
+type PartyAddress struct {
+	Indicator           AddressIndicator
+	SignallingPointCode uint16
+	SubsystemNumber     uint8
+	GlobalTitle         *GlobalTitle
+}
+
+// NewPartyAddressSSN creates a PartyAddress that routes on subsystem number.
+func NewPartyAddressSSN(ssn uint8) *PartyAddress {
+	return &PartyAddress{
+		Indicator:       AddressIndicator{RouteOnSSN: true, HasSSN: true},
+		SubsystemNumber: ssn,
+	}
+}
+
+// NewPartyAddressGT creates a PartyAddress that routes on a GlobalTitle, with
+// an optional subsystem number attached.
+func NewPartyAddressGT(gt *GlobalTitle, ssn uint8) *PartyAddress {
+	pa := &PartyAddress{
+		Indicator:   AddressIndicator{GTI: gt.GTI},
+		GlobalTitle: gt,
+	}
+	if ssn != 0 {
+		pa.Indicator.HasSSN = true
+		pa.SubsystemNumber = ssn
+	}
+	return pa
+}
+
+// MarshalBinary returns the byte sequence generated from a PartyAddress.
+func (p *PartyAddress) MarshalBinary() ([]byte, error) {
+	b := make([]byte, p.MarshalLen())
+	if err := p.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (p *PartyAddress) MarshalTo(b []byte) error {
+	if len(b) < p.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = p.Indicator.byte()
+	offset := 1
+
+	if p.Indicator.HasPC {
+		binary.LittleEndian.PutUint16(b[offset:offset+2], p.SignallingPointCode)
+		offset += 2
+	}
+	if p.Indicator.HasSSN {
+		b[offset] = p.SubsystemNumber
+		offset++
+	}
+	if p.Indicator.GTI != GTINoGlobalTitle {
+		if p.GlobalTitle == nil {
+			return fmt.Errorf("sccp: AddressIndicator requests a GlobalTitle but none is set")
+		}
+		return p.GlobalTitle.MarshalTo(b[offset:])
+	}
+
+	return nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a PartyAddress.
+func (p *PartyAddress) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	p.Indicator = parseAddressIndicator(b[0])
+	offset := 1
+
+	if p.Indicator.HasPC {
+		if len(b) < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		p.SignallingPointCode = binary.LittleEndian.Uint16(b[offset:offset+2]) & 0x3fff
+		offset += 2
+	}
+	if p.Indicator.HasSSN {
+		if len(b) < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		p.SubsystemNumber = b[offset]
+		offset++
+	}
+	if p.Indicator.GTI != GTINoGlobalTitle {
+		gt := &GlobalTitle{GTI: p.Indicator.GTI}
+		if err := gt.UnmarshalBinary(b[offset:]); err != nil {
+			return err
+		}
+		p.GlobalTitle = gt
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (p *PartyAddress) MarshalLen() int {
+	l := 1
+	if p.Indicator.HasPC {
+		l += 2
+	}
+	if p.Indicator.HasSSN {
+		l++
+	}
+	if p.GlobalTitle != nil {
+		l += p.GlobalTitle.MarshalLen()
+	}
+	return l
+}
+
+// String returns the PartyAddress values in human readable format.
+func (p *PartyAddress) String() string {
+	return fmt.Sprintf("{Indicator: %+v, SignallingPointCode: %d, SubsystemNumber: %d, GlobalTitle: %v}",
+		p.Indicator,
+		p.SignallingPointCode,
+		p.SubsystemNumber,
+		p.GlobalTitle,
+	)
+}
+
+// GlobalTitle is the typed form of a SCCP Global Title, covering GTI
+// variants 0001 (NAI only), 0010 (TT only), 0011 (TT+NP+ES) and 0100
+// (TT+NP+ES+NAI).
+type GlobalTitle struct {
+	GTI                      uint8
+	TranslationType          uint8
+	NumberingPlan            uint8
+	EncodingScheme           uint8
+	NatureOfAddressIndicator uint8
+	encodedDigits            []byte
+}
+
+// NewGTIndicator1 creates a GlobalTitle with GTI 0001 (nature of address only).
+func NewGTIndicator1(nai uint8, digits string) *GlobalTitle {
+	gt := &GlobalTitle{GTI: GTINatureOfAddressOnly, NatureOfAddressIndicator: nai, EncodingScheme: EncodingSchemeBCDEven}
+	gt.SetDigits(digits)
+	return gt
+}
+
+// NewGTIndicator2 creates a GlobalTitle with GTI 0010 (translation type only).
+func NewGTIndicator2(tt uint8, digits string) *GlobalTitle {
+	gt := &GlobalTitle{GTI: GTITranslationTypeOnly, TranslationType: tt, EncodingScheme: EncodingSchemeBCDEven}
+	gt.SetDigits(digits)
+	return gt
+}
+
+// NewGTIndicator3 creates a GlobalTitle with GTI 0011 (translation type,
+// numbering plan and encoding scheme).
+func NewGTIndicator3(tt, np uint8, digits string) *GlobalTitle {
+	gt := &GlobalTitle{GTI: GTITranslationTypeNPEncScheme, TranslationType: tt, NumberingPlan: np}
+	gt.SetDigits(digits)
+	return gt
+}
+
+// NewGTIndicator4 creates a GlobalTitle with GTI 0100 (translation type,
+// numbering plan, encoding scheme and nature of address indicator).
+func NewGTIndicator4(tt, np, nai uint8, digits string) *GlobalTitle {
+	gt := &GlobalTitle{GTI: GTITranslationTypeNPEncSchemeNAI, TranslationType: tt, NumberingPlan: np, NatureOfAddressIndicator: nai}
+	gt.SetDigits(digits)
+	return gt
+}
+
+// SetDigits BCD-encodes digits (appending the 0xF padding nibble for an odd
+// number of digits) and updates EncodingScheme accordingly.
+func (g *GlobalTitle) SetDigits(digits string) {
+	g.encodedDigits = encodeBCDDigits(digits)
+	if len(digits)%2 != 0 {
+		g.EncodingScheme = EncodingSchemeBCDOdd
+	} else {
+		g.EncodingScheme = EncodingSchemeBCDEven
+	}
+}
+
+// Digits decodes the BCD-encoded digits, stripping the 0xF padding nibble on
+// an odd-length number.
+func (g *GlobalTitle) Digits() string {
+	return decodeBCDDigits(g.encodedDigits, g.EncodingScheme == EncodingSchemeBCDOdd)
+}
+
+// MarshalBinary returns the byte sequence generated from a GlobalTitle.
+func (g *GlobalTitle) MarshalBinary() ([]byte, error) {
+	b := make([]byte, g.MarshalLen())
+	if err := g.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (g *GlobalTitle) MarshalTo(b []byte) error {
+	if len(b) < g.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	offset := 0
+	switch g.GTI {
+	case GTINatureOfAddressOnly:
+		b[0] = g.NatureOfAddressIndicator
+		offset = 1
+	case GTITranslationTypeOnly:
+		b[0] = g.TranslationType
+		offset = 1
+	case GTITranslationTypeNPEncScheme:
+		b[0] = g.TranslationType
+		b[1] = (g.NumberingPlan << 4) | (g.EncodingScheme & 0x0f)
+		offset = 2
+	case GTITranslationTypeNPEncSchemeNAI:
+		b[0] = g.TranslationType
+		b[1] = (g.NumberingPlan << 4) | (g.EncodingScheme & 0x0f)
+		b[2] = g.NatureOfAddressIndicator
+		offset = 3
+	default:
+		return fmt.Errorf("sccp: unsupported GlobalTitle Indicator %#x", g.GTI)
+	}
+
+	copy(b[offset:], g.encodedDigits)
+	return nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a GlobalTitle.
+func (g *GlobalTitle) UnmarshalBinary(b []byte) error {
+	offset := 0
+	switch g.GTI {
+	case GTINatureOfAddressOnly:
+		if len(b) < 1 {
+			return io.ErrUnexpectedEOF
+		}
+		g.NatureOfAddressIndicator = b[0]
+		offset = 1
+	case GTITranslationTypeOnly:
+		if len(b) < 1 {
+			return io.ErrUnexpectedEOF
+		}
+		g.TranslationType = b[0]
+		offset = 1
+	case GTITranslationTypeNPEncScheme:
+		if len(b) < 2 {
+			return io.ErrUnexpectedEOF
+		}
+		g.TranslationType = b[0]
+		g.NumberingPlan = b[1] >> 4
+		g.EncodingScheme = b[1] & 0x0f
+		offset = 2
+	case GTITranslationTypeNPEncSchemeNAI:
+		if len(b) < 3 {
+			return io.ErrUnexpectedEOF
+		}
+		g.TranslationType = b[0]
+		g.NumberingPlan = b[1] >> 4
+		g.EncodingScheme = b[1] & 0x0f
+		g.NatureOfAddressIndicator = b[2]
+		offset = 3
+	default:
+		return fmt.Errorf("sccp: unsupported GlobalTitle Indicator %#x", g.GTI)
+	}
+
+	g.encodedDigits = b[offset:]
+	if g.GTI == GTINatureOfAddressOnly || g.GTI == GTITranslationTypeOnly {
+		// Unlike GTI 0011/0100, these variants carry no explicit Encoding
+		// Scheme octet, so the odd/even choice SetDigits made has to be
+		// recovered from the wire bytes: an encoded odd-length digit string
+		// ends in the 0xF padding filler, which can never be a real digit.
+		g.EncodingScheme = EncodingSchemeBCDEven
+		if n := len(g.encodedDigits); n > 0 && g.encodedDigits[n-1]>>4&0x0f == 0x0f {
+			g.EncodingScheme = EncodingSchemeBCDOdd
+		}
+	}
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (g *GlobalTitle) MarshalLen() int {
+	l := len(g.encodedDigits)
+	switch g.GTI {
+	case GTINatureOfAddressOnly, GTITranslationTypeOnly:
+		l += 1
+	case GTITranslationTypeNPEncScheme:
+		l += 2
+	case GTITranslationTypeNPEncSchemeNAI:
+		l += 3
+	}
+	return l
+}
+
+// String returns the GlobalTitle values in human readable format.
+func (g *GlobalTitle) String() string {
+	return fmt.Sprintf("{GTI: %#x, TranslationType: %d, NumberingPlan: %d, EncodingScheme: %d, NatureOfAddressIndicator: %d, Digits: %s}",
+		g.GTI,
+		g.TranslationType,
+		g.NumberingPlan,
+		g.EncodingScheme,
+		g.NatureOfAddressIndicator,
+		g.Digits(),
+	)
+}
+
+// encodeBCDDigits packs a decimal digit string into BCD nibbles, padding an
+// odd number of digits with the 0xF filler nibble.
+func encodeBCDDigits(digits string) []byte {
+	b := make([]byte, (len(digits)+1)/2)
+	for i := 0; i < len(b); i++ {
+		lo := digitToBCD(digits[2*i])
+		hi := byte(0x0f)
+		if 2*i+1 < len(digits) {
+			hi = digitToBCD(digits[2*i+1])
+		}
+		b[i] = lo | (hi << 4)
+	}
+	return b
+}
+
+// decodeBCDDigits unpacks BCD nibbles into a decimal digit string. When odd
+// is true, the high nibble of the last octet is the 0xF padding filler and
+// is dropped.
+func decodeBCDDigits(raw []byte, odd bool) string {
+	var sb strings.Builder
+	for i, b := range raw {
+		sb.WriteByte(bcdToDigit(b & 0x0f))
+		hi := (b >> 4) & 0x0f
+		if i == len(raw)-1 && odd {
+			continue
+		}
+		sb.WriteByte(bcdToDigit(hi))
+	}
+	return sb.String()
+}
+
+func digitToBCD(d byte) byte {
+	return d - '0'
+}
+
+func bcdToDigit(n byte) byte {
+	return '0' + n
+}
@@ -0,0 +1,137 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import "testing"
+
+func TestGlobalTitle_DigitsRoundTrip_Even(t *testing.T) {
+	gt := NewGTIndicator4(0, 1, 4, "1234")
+
+	if got := gt.Digits(); got != "1234" {
+		t.Errorf("Digits() = %q, want %q", got, "1234")
+	}
+
+	b, err := gt.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got := &GlobalTitle{GTI: GTITranslationTypeNPEncSchemeNAI}
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if got.Digits() != "1234" {
+		t.Errorf("round-tripped Digits() = %q, want %q", got.Digits(), "1234")
+	}
+}
+
+func TestGlobalTitle_DigitsRoundTrip_Odd(t *testing.T) {
+	gt := NewGTIndicator4(0, 1, 4, "12345")
+
+	b, err := gt.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got := &GlobalTitle{GTI: GTITranslationTypeNPEncSchemeNAI}
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if got.Digits() != "12345" {
+		t.Errorf("round-tripped Digits() = %q, want %q", got.Digits(), "12345")
+	}
+}
+
+func TestGlobalTitle_DigitsRoundTrip_Indicator1(t *testing.T) {
+	for _, digits := range []string{"123", "1234"} {
+		gt := NewGTIndicator1(1, digits)
+
+		b, err := gt.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%q): %v", digits, err)
+		}
+
+		got := &GlobalTitle{GTI: GTINatureOfAddressOnly}
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatalf("UnmarshalBinary(%q): %v", digits, err)
+		}
+		if got.Digits() != digits {
+			t.Errorf("round-tripped Digits() = %q, want %q", got.Digits(), digits)
+		}
+	}
+}
+
+func TestGlobalTitle_DigitsRoundTrip_Indicator2(t *testing.T) {
+	for _, digits := range []string{"123", "1234"} {
+		gt := NewGTIndicator2(1, digits)
+
+		b, err := gt.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%q): %v", digits, err)
+		}
+
+		got := &GlobalTitle{GTI: GTITranslationTypeOnly}
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatalf("UnmarshalBinary(%q): %v", digits, err)
+		}
+		if got.Digits() != digits {
+			t.Errorf("round-tripped Digits() = %q, want %q", got.Digits(), digits)
+		}
+	}
+}
+
+func TestGlobalTitle_DigitsRoundTrip_Indicator3(t *testing.T) {
+	for _, digits := range []string{"123", "1234"} {
+		gt := NewGTIndicator3(1, 4, digits)
+
+		b, err := gt.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%q): %v", digits, err)
+		}
+
+		got := &GlobalTitle{GTI: GTITranslationTypeNPEncScheme}
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatalf("UnmarshalBinary(%q): %v", digits, err)
+		}
+		if got.Digits() != digits {
+			t.Errorf("round-tripped Digits() = %q, want %q", got.Digits(), digits)
+		}
+	}
+}
+
+func TestPartyAddress_SSNRoundTrip(t *testing.T) {
+	pa := NewPartyAddressSSN(8)
+
+	b, err := pa.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got := &PartyAddress{}
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if got.SubsystemNumber != 8 || !got.Indicator.RouteOnSSN {
+		t.Errorf("got %+v, want SSN=8, RouteOnSSN=true", got)
+	}
+}
+
+func TestPartyAddress_GlobalTitleRoundTrip(t *testing.T) {
+	gt := NewGTIndicator4(0, 1, 4, "4915115")
+	pa := NewPartyAddressGT(gt, 6)
+
+	b, err := pa.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got := &PartyAddress{}
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if got.SubsystemNumber != 6 || got.GlobalTitle == nil || got.GlobalTitle.Digits() != "4915115" {
+		t.Errorf("got %s, want SSN=6, digits=4915115", got)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Errors returned by Reassembler.Push.
+var (
+	// ErrSDUTooLarge is returned when reassembling a segment would grow the
+	// SDU beyond the Reassembler's configured maximum size.
+	ErrSDUTooLarge = errors.New("sccp: reassembled SDU exceeds maximum size")
+
+	// ErrSegmentOutOfOrder is returned when a segment arrives whose position
+	// in the sequence contradicts what the wire format says came before it
+	// (e.g. an XUDT Segmentation remaining-count that doesn't decrease by one).
+	ErrSegmentOutOfOrder = errors.New("sccp: segment received out of order")
+
+	// ErrDuplicateSegment is returned when a segment is received for an XUDT
+	// SDU whose final segment has already been seen. DT1 segments are never
+	// reported as duplicates: once a DT1 SDU completes, a further segment on
+	// the same reference starts a new SDU.
+	ErrDuplicateSegment = errors.New("sccp: duplicate segment received")
+
+	// ErrReassemblyTimeout is returned when a segment arrives for an SDU that
+	// has been incomplete for longer than the Reassembler's configured timeout.
+	// The partial SDU is discarded.
+	ErrReassemblyTimeout = errors.New("sccp: SDU reassembly timed out")
+)
+
+// Default tunables used by NewReassembler when given a zero value.
+const (
+	// DefaultMaxSDUSize comfortably exceeds the ~3952 octet ceiling of a
+	// single LUDT, the largest unfragmented SDU this package can carry.
+	DefaultMaxSDUSize = 1 << 16 // 64 KiB
+
+	// DefaultReassemblyTimeout bounds how long an incomplete SDU may sit
+	// idle before it is dropped.
+	DefaultReassemblyTimeout = 10 * time.Second
+)
+
+// reassemblyKey identifies one SDU's worth of segments: the
+// DestinationLocalRef for DT1, or the Segmentation parameter's Segmentation
+// Reference for XUDT.
+type reassemblyKey [4]byte
+
+// sdu tracks the in-progress reassembly of one SDU.
+type sdu struct {
+	data      []byte
+	remaining uint8 // XUDT only: Segmentation.RemainingSegments of the last segment seen.
+	hasMore   bool  // whether another segment is still expected.
+	updatedAt time.Time
+}
+
+// Reassembler reassembles segmented DT1 messages, or XUDTs sharing a
+// Segmentation Reference, back into the original SDU. Fragments must be
+// pushed in the order they were received; a single Reassembler can track
+// many concurrent flows, one per reassemblyKey. It is safe for concurrent use.
+type Reassembler struct {
+	mu      sync.Mutex
+	sdus    map[reassemblyKey]*sdu
+	maxSDU  int
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewReassembler creates a Reassembler. maxSDU bounds the size of any single
+// reassembled SDU (0 uses DefaultMaxSDUSize), and timeout bounds how long an
+// incomplete SDU may sit idle before a further segment for it is rejected
+// with ErrReassemblyTimeout (0 uses DefaultReassemblyTimeout).
+func NewReassembler(maxSDU int, timeout time.Duration) *Reassembler {
+	if maxSDU <= 0 {
+		maxSDU = DefaultMaxSDUSize
+	}
+	if timeout <= 0 {
+		timeout = DefaultReassemblyTimeout
+	}
+	return &Reassembler{
+		sdus:    make(map[reassemblyKey]*sdu),
+		maxSDU:  maxSDU,
+		timeout: timeout,
+		now:     time.Now,
+	}
+}
+
+// Push feeds a single segment into the reassembler. complete is non-nil and
+// done is true once the final segment of its SDU has been received; until
+// then Push buffers the segment and returns (nil, false, nil).
+func (r *Reassembler) Push(msg Message) (complete []byte, done bool, err error) {
+	switch m := msg.(type) {
+	case *DT1:
+		return r.pushDT1(m)
+	case *XUDT:
+		return r.pushXUDT(m)
+	default:
+		return nil, false, fmt.Errorf("sccp: reassembly is not supported for %s messages", msg.MessageTypeName())
+	}
+}
+
+func (r *Reassembler) pushDT1(d *DT1) ([]byte, bool, error) {
+	var key reassemblyKey
+	copy(key[:3], d.DestinationLocalRef)
+
+	more := d.SegmentingReassembling&0x01 != 0
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, inProgress := r.sdus[key]
+	if inProgress && r.now().Sub(s.updatedAt) > r.timeout {
+		delete(r.sdus, key)
+		return nil, false, ErrReassemblyTimeout
+	}
+
+	switch {
+	case !inProgress && !more:
+		// A single, unfragmented DT1: nothing to reassemble.
+		return d.Data, true, nil
+
+	case !inProgress:
+		// The first segment of a new SDU.
+		r.sdus[key] = &sdu{data: append([]byte{}, d.Data...), hasMore: true, updatedAt: r.now()}
+		return nil, false, nil
+
+	default:
+		if len(s.data)+len(d.Data) > r.maxSDU {
+			delete(r.sdus, key)
+			return nil, false, ErrSDUTooLarge
+		}
+
+		s.data = append(s.data, d.Data...)
+		s.updatedAt = r.now()
+		if !more {
+			// DestinationLocalRef is the connection reference, reused for
+			// every DT1 exchanged over a connection's lifetime: drop the
+			// entry on completion rather than leaving a tombstone behind,
+			// so the next, possibly unfragmented, message on this ref isn't
+			// mistaken for a duplicate of the one that just finished.
+			delete(r.sdus, key)
+			return s.data, true, nil
+		}
+		return nil, false, nil
+	}
+}
+
+func (r *Reassembler) pushXUDT(x *XUDT) ([]byte, bool, error) {
+	seg, segmented := x.Segmentation()
+	if !segmented {
+		// An unsegmented XUDT: nothing to reassemble.
+		return x.Data, true, nil
+	}
+
+	key := reassemblyKey(seg.Reference)
+	more := seg.RemainingSegments > 0
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, inProgress := r.sdus[key]
+	if inProgress && s.hasMore && r.now().Sub(s.updatedAt) > r.timeout {
+		delete(r.sdus, key)
+		return nil, false, ErrReassemblyTimeout
+	}
+
+	switch {
+	case (!inProgress || !s.hasMore) && seg.First:
+		// The first segment of a new SDU, or this reference was reused for a
+		// new message once the previous one finished.
+		r.sdus[key] = &sdu{data: append([]byte{}, x.Data...), remaining: seg.RemainingSegments, hasMore: more, updatedAt: r.now()}
+		if !more {
+			return x.Data, true, nil
+		}
+		return nil, false, nil
+
+	case !inProgress || seg.First:
+		// A non-first segment with no prior state for this reference.
+		return nil, false, ErrSegmentOutOfOrder
+
+	case !s.hasMore:
+		// The final segment for this reference has already been seen.
+		return nil, false, ErrDuplicateSegment
+
+	case seg.RemainingSegments != s.remaining-1:
+		delete(r.sdus, key)
+		return nil, false, ErrSegmentOutOfOrder
+
+	default:
+		if len(s.data)+len(x.Data) > r.maxSDU {
+			delete(r.sdus, key)
+			return nil, false, ErrSDUTooLarge
+		}
+
+		s.data = append(s.data, x.Data...)
+		s.remaining = seg.RemainingSegments
+		s.updatedAt = r.now()
+		s.hasMore = more
+		if !more {
+			// Keep a tombstone (hasMore=false) so a stray resend of the final
+			// segment is reported as a duplicate rather than silently treated
+			// as a brand new SDU.
+			return s.data, true, nil
+		}
+		return nil, false, nil
+	}
+}
+
+// Pending reports how many SDUs currently have incomplete segments buffered.
+func (r *Reassembler) Pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, s := range r.sdus {
+		if s.hasMore {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,177 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestReassembler_SingleSegment(t *testing.T) {
+	r := NewReassembler(0, 0)
+	d := NewDT1([]byte{0x01, 0x02, 0x03}, 0, []byte("hello"))
+
+	complete, done, err := r.Push(d)
+	if err != nil {
+		t.Fatalf("Push: %v", err)
+	}
+	if !done || !bytes.Equal(complete, []byte("hello")) {
+		t.Errorf("got (%q, %v), want (%q, true)", complete, done, "hello")
+	}
+}
+
+func TestReassembler_MultipleSegments(t *testing.T) {
+	r := NewReassembler(0, 0)
+	ref := []byte{0x01, 0x02, 0x03}
+
+	first := NewDT1(ref, 1, []byte("foo"))
+	last := NewDT1(ref, 0, []byte("bar"))
+
+	complete, done, err := r.Push(first)
+	if err != nil || done {
+		t.Fatalf("first segment: got (%q, %v, %v), want (nil, false, nil)", complete, done, err)
+	}
+
+	complete, done, err = r.Push(last)
+	if err != nil {
+		t.Fatalf("last segment: %v", err)
+	}
+	if !done || !bytes.Equal(complete, []byte("foobar")) {
+		t.Errorf("got (%q, %v), want (%q, true)", complete, done, "foobar")
+	}
+}
+
+func TestReassembler_InterleavedFlows(t *testing.T) {
+	r := NewReassembler(0, 0)
+	refA := []byte{0x01, 0x00, 0x00}
+	refB := []byte{0x02, 0x00, 0x00}
+
+	if _, done, err := r.Push(NewDT1(refA, 1, []byte("A1"))); err != nil || done {
+		t.Fatalf("A1: %v %v", done, err)
+	}
+	if _, done, err := r.Push(NewDT1(refB, 1, []byte("B1"))); err != nil || done {
+		t.Fatalf("B1: %v %v", done, err)
+	}
+
+	completeB, done, err := r.Push(NewDT1(refB, 0, []byte("B2")))
+	if err != nil || !done || !bytes.Equal(completeB, []byte("B1B2")) {
+		t.Fatalf("B2: got (%q, %v, %v)", completeB, done, err)
+	}
+
+	completeA, done, err := r.Push(NewDT1(refA, 0, []byte("A2")))
+	if err != nil || !done || !bytes.Equal(completeA, []byte("A1A2")) {
+		t.Fatalf("A2: got (%q, %v, %v)", completeA, done, err)
+	}
+}
+
+func TestReassembler_DuplicateSegment(t *testing.T) {
+	r := NewReassembler(0, 0)
+	ref := []byte{0x01, 0x02, 0x03}
+
+	last := NewDT1(ref, 0, []byte("hello"))
+	if _, done, err := r.Push(last); err != nil || !done {
+		t.Fatalf("first push: %v %v", done, err)
+	}
+
+	// The flow is complete and gone; another segment using the same
+	// reference mid-message looks like a continuation with no start, i.e. a
+	// duplicate of an already-finished SDU.
+	dup := NewDT1(ref, 1, []byte("again"))
+	if _, _, err := r.Push(dup); err != nil || r.Pending() != 1 {
+		t.Fatalf("expected dup to start a fresh flow, got err=%v pending=%d", err, r.Pending())
+	}
+
+	if _, _, err := r.Push(NewDT1(ref, 0, []byte("again"))); err != nil {
+		t.Fatalf("unexpected error completing fresh flow: %v", err)
+	}
+
+	// DestinationLocalRef is the connection reference, reused for every DT1
+	// exchanged over a connection's lifetime: a brand-new, unfragmented
+	// message on a ref that has just completed a fragmented SDU must not be
+	// mistaken for a duplicate of it.
+	fresh, done, err := r.Push(NewDT1(ref, 0, []byte("z")))
+	if err != nil || !done || !bytes.Equal(fresh, []byte("z")) {
+		t.Errorf("got (%q, %v, %v), want (%q, true, nil)", fresh, done, err, "z")
+	}
+	if r.Pending() != 0 {
+		t.Errorf("Pending() = %d, want 0", r.Pending())
+	}
+}
+
+func TestReassembler_Oversize(t *testing.T) {
+	r := NewReassembler(4, 0)
+	ref := []byte{0x01, 0x02, 0x03}
+
+	if _, _, err := r.Push(NewDT1(ref, 1, []byte("ab"))); err != nil {
+		t.Fatalf("first segment: %v", err)
+	}
+	if _, _, err := r.Push(NewDT1(ref, 0, []byte("abc"))); err != ErrSDUTooLarge {
+		t.Errorf("got err=%v, want ErrSDUTooLarge", err)
+	}
+	if r.Pending() != 0 {
+		t.Errorf("oversize SDU should be dropped, Pending()=%d", r.Pending())
+	}
+}
+
+func TestReassembler_Timeout(t *testing.T) {
+	r := NewReassembler(0, time.Second)
+	ref := []byte{0x01, 0x02, 0x03}
+
+	fakeNow := time.Now()
+	r.now = func() time.Time { return fakeNow }
+
+	if _, _, err := r.Push(NewDT1(ref, 1, []byte("foo"))); err != nil {
+		t.Fatalf("first segment: %v", err)
+	}
+
+	fakeNow = fakeNow.Add(2 * time.Second)
+	if _, _, err := r.Push(NewDT1(ref, 0, []byte("bar"))); err != ErrReassemblyTimeout {
+		t.Errorf("got err=%v, want ErrReassemblyTimeout", err)
+	}
+	if r.Pending() != 0 {
+		t.Errorf("timed-out SDU should be dropped, Pending()=%d", r.Pending())
+	}
+}
+
+func segmentedXUDT(ref [4]byte, first bool, remaining uint8, data []byte) *XUDT {
+	seg := &Segmentation{First: first, Class: 1, RemainingSegments: remaining, Reference: ref}
+	segBytes, _ := seg.MarshalBinary()
+	params := []OptionalParameter{{Name: ParamSegmentation, Length: uint8(len(segBytes)), Value: segBytes}}
+
+	return NewXUDT(1, 15, NewPartyAddressSSN(8), NewPartyAddressSSN(6), data, params)
+}
+
+func TestReassembler_XUDTMultipleSegments(t *testing.T) {
+	r := NewReassembler(0, 0)
+	ref := [4]byte{0x01, 0x02, 0x03, 0x04}
+
+	complete, done, err := r.Push(segmentedXUDT(ref, true, 1, []byte("foo")))
+	if err != nil || done {
+		t.Fatalf("first segment: got (%q, %v, %v), want (nil, false, nil)", complete, done, err)
+	}
+
+	complete, done, err = r.Push(segmentedXUDT(ref, false, 0, []byte("bar")))
+	if err != nil {
+		t.Fatalf("last segment: %v", err)
+	}
+	if !done || !bytes.Equal(complete, []byte("foobar")) {
+		t.Errorf("got (%q, %v), want (%q, true)", complete, done, "foobar")
+	}
+}
+
+func TestReassembler_XUDTOutOfOrder(t *testing.T) {
+	r := NewReassembler(0, 0)
+	ref := [4]byte{0x01, 0x02, 0x03, 0x04}
+
+	if _, done, err := r.Push(segmentedXUDT(ref, true, 2, []byte("foo"))); err != nil || done {
+		t.Fatalf("first segment: %v %v", done, err)
+	}
+
+	// Skips remaining=1 and jumps straight to remaining=0.
+	if _, _, err := r.Push(segmentedXUDT(ref, false, 0, []byte("bar"))); err != ErrSegmentOutOfOrder {
+		t.Errorf("got err=%v, want ErrSegmentOutOfOrder", err)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// RLC represents a SCCP Release Complete message.
+type RLC struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	SourceLocalRef      []byte
+}
+
+// NewRLC creates a new RLC.
+func NewRLC(destinationLocalRef, sourceLocalRef []byte) *RLC {
+	return &RLC{
+		Type:                MsgTypeRLC,
+		DestinationLocalRef: destinationLocalRef,
+		SourceLocalRef:      sourceLocalRef,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a RLC instance.
+func (r *RLC) MarshalBinary() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (r *RLC) MarshalTo(b []byte) error {
+	if len(b) < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(r.Type)
+	copy(b[1:4], r.DestinationLocalRef)
+	copy(b[4:7], r.SourceLocalRef)
+
+	return nil
+}
+
+// ParseRLC decodes given byte sequence as a SCCP RLC.
+func ParseRLC(b []byte) (*RLC, error) {
+	r := &RLC{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP RLC.
+func (r *RLC) UnmarshalBinary(b []byte) error {
+	if len(b) < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	r.Type = MsgType(b[0])
+	r.DestinationLocalRef = b[1:4]
+	r.SourceLocalRef = b[4:7]
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (r *RLC) MarshalLen() int {
+	return 7
+}
+
+// String returns the RLC values in human readable format.
+func (r *RLC) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, SourceLocalRef: %v}",
+		r.Type,
+		r.DestinationLocalRef,
+		r.SourceLocalRef,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (r *RLC) MessageType() MsgType {
+	return MsgTypeRLC
+}
+
+// MessageTypeName returns the Message Type in string.
+func (r *RLC) MessageTypeName() string {
+	return "RLC"
+}
@@ -0,0 +1,124 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// RLSD represents a SCCP Released message.
+type RLSD struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	SourceLocalRef      []byte
+	ReleaseCause        uint8
+	Ptr1                uint8 // pointer to optional part, 0 if absent
+	OptionalPart        []byte
+}
+
+// NewRLSD creates a new RLSD.
+func NewRLSD(destinationLocalRef, sourceLocalRef []byte, releaseCause uint8) *RLSD {
+	return &RLSD{
+		Type:                MsgTypeRLSD,
+		DestinationLocalRef: destinationLocalRef,
+		SourceLocalRef:      sourceLocalRef,
+		ReleaseCause:        releaseCause,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a RLSD instance.
+func (r *RLSD) MarshalBinary() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (r *RLSD) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 9 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(r.Type)
+	copy(b[1:4], r.DestinationLocalRef)
+	copy(b[4:7], r.SourceLocalRef)
+	b[7] = r.ReleaseCause
+	b[8] = r.Ptr1
+
+	if r.Ptr1 != 0 {
+		offset := int(r.Ptr1) + 8
+		if l < offset+len(r.OptionalPart) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[offset:], r.OptionalPart)
+	}
+
+	return nil
+}
+
+// ParseRLSD decodes given byte sequence as a SCCP RLSD.
+func ParseRLSD(b []byte) (*RLSD, error) {
+	r := &RLSD{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP RLSD.
+func (r *RLSD) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 9 {
+		return io.ErrUnexpectedEOF
+	}
+
+	r.Type = MsgType(b[0])
+	r.DestinationLocalRef = b[1:4]
+	r.SourceLocalRef = b[4:7]
+	r.ReleaseCause = b[7]
+	r.Ptr1 = b[8]
+
+	if r.Ptr1 != 0 {
+		offset := int(r.Ptr1) + 8
+		if l < offset {
+			return io.ErrUnexpectedEOF
+		}
+		r.OptionalPart = b[offset:]
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (r *RLSD) MarshalLen() int {
+	return 9 + len(r.OptionalPart)
+}
+
+// String returns the RLSD values in human readable format.
+func (r *RLSD) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, SourceLocalRef: %v, ReleaseCause: %d, OptionalPart: %x}",
+		r.Type,
+		r.DestinationLocalRef,
+		r.SourceLocalRef,
+		r.ReleaseCause,
+		r.OptionalPart,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (r *RLSD) MessageType() MsgType {
+	return MsgTypeRLSD
+}
+
+// MessageTypeName returns the Message Type in string.
+func (r *RLSD) MessageTypeName() string {
+	return "RLSD"
+}
@@ -0,0 +1,101 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// RSR represents a SCCP Reset Request message.
+type RSR struct {
+	Type                MsgType
+	DestinationLocalRef []byte
+	SourceLocalRef      []byte
+	ResetCause          uint8
+}
+
+// NewRSR creates a new RSR.
+func NewRSR(destinationLocalRef, sourceLocalRef []byte, resetCause uint8) *RSR {
+	return &RSR{
+		Type:                MsgTypeRSR,
+		DestinationLocalRef: destinationLocalRef,
+		SourceLocalRef:      sourceLocalRef,
+		ResetCause:          resetCause,
+	}
+}
+
+// MarshalBinary returns the byte sequence generated from a RSR instance.
+func (r *RSR) MarshalBinary() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (r *RSR) MarshalTo(b []byte) error {
+	if len(b) < 8 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(r.Type)
+	copy(b[1:4], r.DestinationLocalRef)
+	copy(b[4:7], r.SourceLocalRef)
+	b[7] = r.ResetCause
+
+	return nil
+}
+
+// ParseRSR decodes given byte sequence as a SCCP RSR.
+func ParseRSR(b []byte) (*RSR, error) {
+	r := &RSR{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP RSR.
+func (r *RSR) UnmarshalBinary(b []byte) error {
+	if len(b) < 8 {
+		return io.ErrUnexpectedEOF
+	}
+
+	r.Type = MsgType(b[0])
+	r.DestinationLocalRef = b[1:4]
+	r.SourceLocalRef = b[4:7]
+	r.ResetCause = b[7]
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (r *RSR) MarshalLen() int {
+	return 8
+}
+
+// String returns the RSR values in human readable format.
+func (r *RSR) String() string {
+	return fmt.Sprintf("{Type: %d, DestinationLocalRef: %v, SourceLocalRef: %v, ResetCause: %d}",
+		r.Type,
+		r.DestinationLocalRef,
+		r.SourceLocalRef,
+		r.ResetCause,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (r *RSR) MessageType() MsgType {
+	return MsgTypeRSR
+}
+
+// MessageTypeName returns the Message Type in string.
+func (r *RSR) MessageTypeName() string {
+	return "RSR"
+}
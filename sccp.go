@@ -55,38 +55,50 @@ type Message interface {
 }
 
 // ParseMessage decodes the byte sequence into Message by Message Type.
-// Currently this only supports UDT type of message only.
 func ParseMessage(b []byte) (Message, error) {
 	var m Message
 	switch MsgType(b[0]) {
-	/* TODO: implement!
-	case CR:
-	case CC:
-	case CREF:
-	case RLSD:
-	case RLC:
-	*/
+	case MsgTypeCR:
+		m = &CR{}
+	case MsgTypeCC:
+		m = &CC{}
+	case MsgTypeCREF:
+		m = &CREF{}
+	case MsgTypeRLSD:
+		m = &RLSD{}
+	case MsgTypeRLC:
+		m = &RLC{}
 	case MsgTypeDT1:
 		m = &DT1{}
-	/* TODO: implement!
-	case DT2:
-	case AK:
-	*/
+	case MsgTypeDT2:
+		m = &DT2{}
+	case MsgTypeAK:
+		m = &AK{}
 	case MsgTypeUDT:
 		m = &UDT{}
 	/* TODO: implement!
 	case UDTS:
-	case ED:
-	case EA:
-	case RSR:
-	case RSC:
-	case ERR:
-	case IT:
-	case XUDT:
-	case XUDTS:
-	case LUDT:
-	case LUDTS:
 	*/
+	case MsgTypeED:
+		m = &ED{}
+	case MsgTypeEA:
+		m = &EA{}
+	case MsgTypeRSR:
+		m = &RSR{}
+	case MsgTypeRSC:
+		m = &RSC{}
+	case MsgTypeERR:
+		m = &ERR{}
+	case MsgTypeIT:
+		m = &IT{}
+	case MsgTypeXUDT:
+		m = &XUDT{}
+	case MsgTypeXUDTS:
+		m = &XUDTS{}
+	case MsgTypeLUDT:
+		m = &LUDT{}
+	case MsgTypeLUDTS:
+		m = &LUDTS{}
 	default:
 		return nil, UnsupportedTypeError(b[0])
 	}
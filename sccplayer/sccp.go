@@ -0,0 +1,176 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+/*
+Package sccplayer integrates the sccp package with gopacket, registering SCCP
+as a gopacket.Layer that can be decoded on top of SCTP/M3UA and chained into
+upper layers such as TCAP/MAP. It lets callers do packet.Layer(LayerTypeSCCP)
+and track flows by CalledPartyAddress/CallingPartyAddress.
+*/
+package sccplayer
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stuchl4n3k/go-sccp"
+)
+
+// LayerTypeSCCP is the gopacket layer type assigned to SCCP messages.
+var LayerTypeSCCP = gopacket.RegisterLayerType(
+	1720,
+	gopacket.LayerTypeMetadata{Name: "SCCP", Decoder: gopacket.DecodeFunc(decodeSCCP)},
+)
+
+func decodeSCCP(data []byte, p gopacket.PacketBuilder) error {
+	s := &SCCP{}
+	if err := s.DecodeFromBytes(data, p); err != nil {
+		return err
+	}
+	p.AddLayer(s)
+	return p.NextDecoder(s.NextLayerType())
+}
+
+// SCCP is a gopacket.Layer wrapping a decoded sccp.Message.
+type SCCP struct {
+	layers.BaseLayer
+
+	// Message is the decoded SCCP message carried by this layer.
+	Message sccp.Message
+
+	// CalledPartyAddress and CallingPartyAddress hold the address of
+	// messages that carry them (CR, UDT, XUDT, XUDTS, LUDT, LUDTS). They
+	// are nil for message types that don't carry an address, such as DT1.
+	CalledPartyAddress  *sccp.PartyAddress
+	CallingPartyAddress *sccp.PartyAddress
+}
+
+// LayerType returns LayerTypeSCCP.
+func (s *SCCP) LayerType() gopacket.LayerType {
+	return LayerTypeSCCP
+}
+
+// CanDecode returns the set of layer types this layer can decode.
+func (s *SCCP) CanDecode() gopacket.LayerClass {
+	return LayerTypeSCCP
+}
+
+// NextLayerType returns the layer type of the SCCP payload, if any.
+func (s *SCCP) NextLayerType() gopacket.LayerType {
+	switch s.Message.(type) {
+	case *sccp.DT1, *sccp.DT2, *sccp.UDT, *sccp.XUDT, *sccp.XUDTS, *sccp.LUDT, *sccp.LUDTS:
+		return LayerTypeSCCPPayload
+	default:
+		return gopacket.LayerTypePayload
+	}
+}
+
+// DecodeFromBytes decodes the given bytes as an SCCP message into this layer.
+func (s *SCCP) DecodeFromBytes(data []byte, df gopacket.PacketBuilder) error {
+	msg, err := sccp.ParseMessage(data)
+	if err != nil {
+		return err
+	}
+
+	s.Message = msg
+	s.BaseLayer = layers.BaseLayer{Contents: data[:msg.MarshalLen()], Payload: data[msg.MarshalLen():]}
+
+	switch m := msg.(type) {
+	case *sccp.CR:
+		s.CalledPartyAddress = m.CalledPartyAddress
+		if calling, ok := m.CallingPartyAddress(); ok {
+			s.CallingPartyAddress = calling
+		}
+	case *sccp.UDT:
+		s.CalledPartyAddress = m.CalledPartyAddress
+		s.CallingPartyAddress = m.CallingPartyAddress
+		s.BaseLayer.Payload = m.Data
+	case *sccp.XUDT:
+		s.CalledPartyAddress = m.CalledPartyAddress
+		s.CallingPartyAddress = m.CallingPartyAddress
+		s.BaseLayer.Payload = m.Data
+	case *sccp.XUDTS:
+		s.CalledPartyAddress = m.CalledPartyAddress
+		s.CallingPartyAddress = m.CallingPartyAddress
+		s.BaseLayer.Payload = m.Data
+	case *sccp.LUDT:
+		s.CalledPartyAddress = m.CalledPartyAddress
+		s.CallingPartyAddress = m.CallingPartyAddress
+		s.BaseLayer.Payload = m.Data
+	case *sccp.LUDTS:
+		s.CalledPartyAddress = m.CalledPartyAddress
+		s.CallingPartyAddress = m.CallingPartyAddress
+		s.BaseLayer.Payload = m.Data
+	case *sccp.DT1:
+		s.BaseLayer.Payload = m.Data
+	case *sccp.DT2:
+		s.BaseLayer.Payload = m.Data
+	}
+
+	return nil
+}
+
+// SerializeTo writes the serialized form of this layer into b.
+func (s *SCCP) SerializeTo(b gopacket.SerializeBuffer, opts gopacket.SerializeOptions) error {
+	bytes, err := b.PrependBytes(s.Message.MarshalLen())
+	if err != nil {
+		return err
+	}
+
+	return s.Message.MarshalTo(bytes)
+}
+
+// LayerTypeSCCPPayload is the gopacket layer type assigned to the user data
+// carried by a DT1, DT2, UDT, XUDT, XUDTS, LUDT or LUDTS message (i.e. its
+// Data field).
+var LayerTypeSCCPPayload = gopacket.RegisterLayerType(
+	1721,
+	gopacket.LayerTypeMetadata{Name: "SCCPPayload", Decoder: gopacket.DecodeFunc(decodeSCCPPayload)},
+)
+
+func decodeSCCPPayload(data []byte, p gopacket.PacketBuilder) error {
+	s := &SCCPPayload{}
+	if err := s.DecodeFromBytes(data, p); err != nil {
+		return err
+	}
+	p.AddLayer(s)
+	return p.NextDecoder(s.NextLayerType())
+}
+
+// SCCPPayload is the gopacket.Layer carrying the user data of a data-bearing
+// SCCP message, so that upper layers (e.g. TCAP/MAP) can be chained onto it.
+type SCCPPayload struct {
+	layers.BaseLayer
+}
+
+// LayerType returns LayerTypeSCCPPayload.
+func (s *SCCPPayload) LayerType() gopacket.LayerType {
+	return LayerTypeSCCPPayload
+}
+
+// CanDecode returns the set of layer types this layer can decode.
+func (s *SCCPPayload) CanDecode() gopacket.LayerClass {
+	return LayerTypeSCCPPayload
+}
+
+// NextLayerType returns gopacket.LayerTypePayload, as no further decoder is
+// registered for the SCCP user data by default.
+func (s *SCCPPayload) NextLayerType() gopacket.LayerType {
+	return gopacket.LayerTypePayload
+}
+
+// DecodeFromBytes decodes the given bytes as an SCCP payload into this layer.
+func (s *SCCPPayload) DecodeFromBytes(data []byte, df gopacket.PacketBuilder) error {
+	s.BaseLayer = layers.BaseLayer{Contents: data}
+	return nil
+}
+
+// SerializeTo writes the serialized form of this layer into b.
+func (s *SCCPPayload) SerializeTo(b gopacket.SerializeBuffer, opts gopacket.SerializeOptions) error {
+	bytes, err := b.PrependBytes(len(s.Contents))
+	if err != nil {
+		return err
+	}
+	copy(bytes, s.Contents)
+	return nil
+}
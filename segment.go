@@ -0,0 +1,56 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+// MaxDT1SDUSize is the largest SDU that a single, unfragmented DT1 can carry
+// (255 octets, the maximum value of DataLength).
+const MaxDT1SDUSize = 255
+
+// Segmenter splits an oversized SDU into a sequence of DT1s, setting the
+// "more data" bit (the low-order bit of SegmentingReassembling) on every
+// segment but the last, mirroring how SCTP marks the B/E bits of a
+// fragmented DATA chunk.
+type Segmenter struct {
+	// SegmentSize is the maximum amount of SDU data carried per DT1.
+	// It is clamped to (0, MaxDT1SDUSize] by NewSegmenter.
+	SegmentSize int
+}
+
+// NewSegmenter creates a Segmenter producing DT1s with up to segmentSize
+// octets of data each. A segmentSize that is zero or exceeds MaxDT1SDUSize
+// falls back to MaxDT1SDUSize.
+func NewSegmenter(segmentSize int) *Segmenter {
+	if segmentSize <= 0 || segmentSize > MaxDT1SDUSize {
+		segmentSize = MaxDT1SDUSize
+	}
+
+	return &Segmenter{SegmentSize: segmentSize}
+}
+
+// Segment splits sdu into a correctly ordered sequence of DT1s addressed to
+// destinationLocalRef. "More data" is set on every segment but the last; an
+// SDU that fits in a single segment yields exactly one DT1 with "more data"
+// unset.
+func (s *Segmenter) Segment(destinationLocalRef []byte, sdu []byte) []*DT1 {
+	if len(sdu) == 0 {
+		return []*DT1{NewDT1(destinationLocalRef, 0, nil)}
+	}
+
+	var segments []*DT1
+	for offset := 0; offset < len(sdu); offset += s.SegmentSize {
+		end := offset + s.SegmentSize
+		if end > len(sdu) {
+			end = len(sdu)
+		}
+
+		var flag byte
+		if end < len(sdu) {
+			flag = 1 // More Data
+		}
+		segments = append(segments, NewDT1(destinationLocalRef, flag, sdu[offset:end]))
+	}
+
+	return segments
+}
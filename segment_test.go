@@ -0,0 +1,74 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSegmenter_Segment_Unfragmented(t *testing.T) {
+	s := NewSegmenter(0)
+	segments := s.Segment([]byte{0x01, 0x02, 0x03}, []byte("hello"))
+
+	if len(segments) != 1 {
+		t.Fatalf("got %d segments, want 1", len(segments))
+	}
+	if segments[0].SegmentingReassembling&0x01 != 0 {
+		t.Errorf("unfragmented SDU must not have More Data set")
+	}
+}
+
+func TestSegmenter_Segment_Fragmented(t *testing.T) {
+	s := NewSegmenter(4)
+	sdu := []byte("0123456789") // 10 bytes -> 3 segments of 4,4,2
+
+	segments := s.Segment([]byte{0x01, 0x02, 0x03}, sdu)
+	if len(segments) != 3 {
+		t.Fatalf("got %d segments, want 3", len(segments))
+	}
+
+	for i, seg := range segments {
+		more := seg.SegmentingReassembling&0x01 != 0
+		if i < len(segments)-1 && !more {
+			t.Errorf("segment %d: More Data must be set", i)
+		}
+		if i == len(segments)-1 && more {
+			t.Errorf("last segment: More Data must be unset")
+		}
+	}
+
+	var rebuilt []byte
+	for _, seg := range segments {
+		rebuilt = append(rebuilt, seg.Data...)
+	}
+	if !bytes.Equal(rebuilt, sdu) {
+		t.Errorf("rebuilt SDU = %x, want %x", rebuilt, sdu)
+	}
+}
+
+func TestSegmenter_RoundTripThroughReassembler(t *testing.T) {
+	s := NewSegmenter(4)
+	sdu := []byte("the quick brown fox")
+	segments := s.Segment([]byte{0xaa, 0xbb, 0xcc}, sdu)
+
+	r := NewReassembler(0, 0)
+	var complete []byte
+	var done bool
+	for _, seg := range segments {
+		var err error
+		complete, done, err = r.Push(seg)
+		if err != nil {
+			t.Fatalf("Push: %v", err)
+		}
+	}
+
+	if !done {
+		t.Fatalf("reassembly did not complete")
+	}
+	if !bytes.Equal(complete, sdu) {
+		t.Errorf("reassembled SDU = %q, want %q", complete, sdu)
+	}
+}
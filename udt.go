@@ -0,0 +1,189 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// UDT represents a SCCP Unitdata message.
+type UDT struct {
+	Type                MsgType
+	ProtocolClass       uint8
+	Ptr1                uint8 // -> CalledPartyAddress
+	Ptr2                uint8 // -> CallingPartyAddress
+	Ptr3                uint8 // -> Data
+	CalledPartyAddress  *PartyAddress
+	CallingPartyAddress *PartyAddress
+	Data                []byte
+}
+
+// NewUDT creates a new UDT.
+func NewUDT(protocolClass uint8, calledPartyAddress, callingPartyAddress *PartyAddress, data []byte) *UDT {
+	u := &UDT{
+		Type:                MsgTypeUDT,
+		ProtocolClass:       protocolClass,
+		CalledPartyAddress:  calledPartyAddress,
+		CallingPartyAddress: callingPartyAddress,
+		Data:                data,
+	}
+	u.updatePointers()
+
+	return u
+}
+
+// updatePointers recomputes Ptr1-Ptr3 from the current field contents.
+func (u *UDT) updatePointers() {
+	offsetCalled := 5
+	u.Ptr1 = uint8(offsetCalled - 2)
+
+	offsetCalling := offsetCalled + 1 + u.CalledPartyAddress.MarshalLen()
+	u.Ptr2 = uint8(offsetCalling - 3)
+
+	offsetData := offsetCalling + 1 + u.CallingPartyAddress.MarshalLen()
+	u.Ptr3 = uint8(offsetData - 4)
+}
+
+// MarshalBinary returns the byte sequence generated from a UDT instance.
+func (u *UDT) MarshalBinary() ([]byte, error) {
+	b := make([]byte, u.MarshalLen())
+	if err := u.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (u *UDT) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(u.Type)
+	b[1] = u.ProtocolClass
+	b[2] = u.Ptr1
+	b[3] = u.Ptr2
+	b[4] = u.Ptr3
+
+	calledLen := u.CalledPartyAddress.MarshalLen()
+	calledOffset := int(u.Ptr1) + 2
+	if l < calledOffset+1+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	b[calledOffset] = uint8(calledLen)
+	if err := u.CalledPartyAddress.MarshalTo(b[calledOffset+1 : calledOffset+1+calledLen]); err != nil {
+		return err
+	}
+
+	callingLen := u.CallingPartyAddress.MarshalLen()
+	callingOffset := int(u.Ptr2) + 3
+	if l < callingOffset+1+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	b[callingOffset] = uint8(callingLen)
+	if err := u.CallingPartyAddress.MarshalTo(b[callingOffset+1 : callingOffset+1+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(u.Ptr3) + 4
+	if l < dataOffset+1+len(u.Data) {
+		return io.ErrUnexpectedEOF
+	}
+	b[dataOffset] = uint8(len(u.Data))
+	copy(b[dataOffset+1:], u.Data)
+
+	return nil
+}
+
+// ParseUDT decodes given byte sequence as a SCCP UDT.
+func ParseUDT(b []byte) (*UDT, error) {
+	u := &UDT{}
+	if err := u.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP UDT.
+func (u *UDT) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	u.Type = MsgType(b[0])
+	u.ProtocolClass = b[1]
+	u.Ptr1 = b[2]
+	u.Ptr2 = b[3]
+	u.Ptr3 = b[4]
+
+	calledOffset := int(u.Ptr1) + 2
+	if l <= calledOffset {
+		return io.ErrUnexpectedEOF
+	}
+	calledLen := int(b[calledOffset])
+	if l < calledOffset+1+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	u.CalledPartyAddress = &PartyAddress{}
+	if err := u.CalledPartyAddress.UnmarshalBinary(b[calledOffset+1 : calledOffset+1+calledLen]); err != nil {
+		return err
+	}
+
+	callingOffset := int(u.Ptr2) + 3
+	if l <= callingOffset {
+		return io.ErrUnexpectedEOF
+	}
+	callingLen := int(b[callingOffset])
+	if l < callingOffset+1+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	u.CallingPartyAddress = &PartyAddress{}
+	if err := u.CallingPartyAddress.UnmarshalBinary(b[callingOffset+1 : callingOffset+1+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(u.Ptr3) + 4
+	if l <= dataOffset {
+		return io.ErrUnexpectedEOF
+	}
+	dataLen := int(b[dataOffset])
+	if l < dataOffset+1+dataLen {
+		return io.ErrUnexpectedEOF
+	}
+	u.Data = b[dataOffset+1 : dataOffset+1+dataLen]
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (u *UDT) MarshalLen() int {
+	return 5 + 1 + u.CalledPartyAddress.MarshalLen() + 1 + u.CallingPartyAddress.MarshalLen() + 1 + len(u.Data)
+}
+
+// String returns the UDT values in human readable format.
+func (u *UDT) String() string {
+	return fmt.Sprintf("{Type: %d, ProtocolClass: %d, CalledPartyAddress: %s, CallingPartyAddress: %s, Data: %x}",
+		u.Type,
+		u.ProtocolClass,
+		u.CalledPartyAddress,
+		u.CallingPartyAddress,
+		u.Data,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (u *UDT) MessageType() MsgType {
+	return MsgTypeUDT
+}
+
+// MessageTypeName returns the Message Type in string.
+func (u *UDT) MessageTypeName() string {
+	return "UDT"
+}
@@ -0,0 +1,250 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// XUDT represents a SCCP Extended Unitdata message: like UDT, but with an
+// added Hop Counter and an optional parameter part, used to carry data that
+// doesn't fit in a single UDT (see Segmentation).
+type XUDT struct {
+	Type                MsgType
+	ProtocolClass       uint8
+	HopCounter          uint8
+	Ptr1                uint8 // -> CalledPartyAddress
+	Ptr2                uint8 // -> CallingPartyAddress
+	Ptr3                uint8 // -> Data
+	Ptr4                uint8 // -> optional part, 0 if absent
+	CalledPartyAddress  *PartyAddress
+	CallingPartyAddress *PartyAddress
+	Data                []byte
+	OptionalParameters  []OptionalParameter
+}
+
+// NewXUDT creates a new XUDT.
+func NewXUDT(protocolClass, hopCounter uint8, calledPartyAddress, callingPartyAddress *PartyAddress, data []byte, optionalParameters []OptionalParameter) *XUDT {
+	x := &XUDT{
+		Type:                MsgTypeXUDT,
+		ProtocolClass:       protocolClass,
+		HopCounter:          hopCounter,
+		CalledPartyAddress:  calledPartyAddress,
+		CallingPartyAddress: callingPartyAddress,
+		Data:                data,
+		OptionalParameters:  optionalParameters,
+	}
+	x.updatePointers()
+
+	return x
+}
+
+// updatePointers recomputes Ptr1-Ptr4 from the current field contents.
+func (x *XUDT) updatePointers() {
+	offsetCalled := 7
+	x.Ptr1 = uint8(offsetCalled - 3)
+
+	offsetCalling := offsetCalled + 1 + x.CalledPartyAddress.MarshalLen()
+	x.Ptr2 = uint8(offsetCalling - 4)
+
+	offsetData := offsetCalling + 1 + x.CallingPartyAddress.MarshalLen()
+	x.Ptr3 = uint8(offsetData - 5)
+
+	if len(x.OptionalParameters) == 0 {
+		x.Ptr4 = 0
+		return
+	}
+
+	offsetOptional := offsetData + 1 + len(x.Data)
+	x.Ptr4 = uint8(offsetOptional - 6)
+}
+
+// Segmentation returns the Segmentation optional parameter, if present.
+func (x *XUDT) Segmentation() (*Segmentation, bool) {
+	p, ok := findOptionalParameter(x.OptionalParameters, ParamSegmentation)
+	if !ok {
+		return nil, false
+	}
+
+	seg := &Segmentation{}
+	if err := seg.UnmarshalBinary(p.Value); err != nil {
+		return nil, false
+	}
+	return seg, true
+}
+
+// MarshalBinary returns the byte sequence generated from a XUDT instance.
+func (x *XUDT) MarshalBinary() ([]byte, error) {
+	b := make([]byte, x.MarshalLen())
+	if err := x.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (x *XUDT) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(x.Type)
+	b[1] = x.ProtocolClass
+	b[2] = x.HopCounter
+	b[3] = x.Ptr1
+	b[4] = x.Ptr2
+	b[5] = x.Ptr3
+	b[6] = x.Ptr4
+
+	calledLen := x.CalledPartyAddress.MarshalLen()
+	calledOffset := int(x.Ptr1) + 3
+	if l < calledOffset+1+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	b[calledOffset] = uint8(calledLen)
+	if err := x.CalledPartyAddress.MarshalTo(b[calledOffset+1 : calledOffset+1+calledLen]); err != nil {
+		return err
+	}
+
+	callingLen := x.CallingPartyAddress.MarshalLen()
+	callingOffset := int(x.Ptr2) + 4
+	if l < callingOffset+1+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	b[callingOffset] = uint8(callingLen)
+	if err := x.CallingPartyAddress.MarshalTo(b[callingOffset+1 : callingOffset+1+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(x.Ptr3) + 5
+	if l < dataOffset+1+len(x.Data) {
+		return io.ErrUnexpectedEOF
+	}
+	b[dataOffset] = uint8(len(x.Data))
+	copy(b[dataOffset+1:], x.Data)
+
+	if x.Ptr4 != 0 {
+		optOffset := int(x.Ptr4) + 6
+		opt := MarshalOptionalParameters(x.OptionalParameters)
+		if l < optOffset+len(opt) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[optOffset:], opt)
+	}
+
+	return nil
+}
+
+// ParseXUDT decodes given byte sequence as a SCCP XUDT.
+func ParseXUDT(b []byte) (*XUDT, error) {
+	x := &XUDT{}
+	if err := x.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP XUDT.
+func (x *XUDT) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	x.Type = MsgType(b[0])
+	x.ProtocolClass = b[1]
+	x.HopCounter = b[2]
+	x.Ptr1 = b[3]
+	x.Ptr2 = b[4]
+	x.Ptr3 = b[5]
+	x.Ptr4 = b[6]
+
+	calledOffset := int(x.Ptr1) + 3
+	if l <= calledOffset {
+		return io.ErrUnexpectedEOF
+	}
+	calledLen := int(b[calledOffset])
+	if l < calledOffset+1+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	x.CalledPartyAddress = &PartyAddress{}
+	if err := x.CalledPartyAddress.UnmarshalBinary(b[calledOffset+1 : calledOffset+1+calledLen]); err != nil {
+		return err
+	}
+
+	callingOffset := int(x.Ptr2) + 4
+	if l <= callingOffset {
+		return io.ErrUnexpectedEOF
+	}
+	callingLen := int(b[callingOffset])
+	if l < callingOffset+1+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	x.CallingPartyAddress = &PartyAddress{}
+	if err := x.CallingPartyAddress.UnmarshalBinary(b[callingOffset+1 : callingOffset+1+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(x.Ptr3) + 5
+	if l <= dataOffset {
+		return io.ErrUnexpectedEOF
+	}
+	dataLen := int(b[dataOffset])
+	if l < dataOffset+1+dataLen {
+		return io.ErrUnexpectedEOF
+	}
+	x.Data = b[dataOffset+1 : dataOffset+1+dataLen]
+
+	if x.Ptr4 != 0 {
+		optOffset := int(x.Ptr4) + 6
+		if l < optOffset {
+			return io.ErrUnexpectedEOF
+		}
+		params, err := ParseOptionalParameters(b[optOffset:])
+		if err != nil {
+			return err
+		}
+		x.OptionalParameters = params
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (x *XUDT) MarshalLen() int {
+	l := 7 + 1 + x.CalledPartyAddress.MarshalLen() + 1 + x.CallingPartyAddress.MarshalLen() + 1 + len(x.Data)
+	if len(x.OptionalParameters) > 0 {
+		l += len(MarshalOptionalParameters(x.OptionalParameters))
+	}
+
+	return l
+}
+
+// String returns the XUDT values in human readable format.
+func (x *XUDT) String() string {
+	return fmt.Sprintf("{Type: %d, ProtocolClass: %d, HopCounter: %d, CalledPartyAddress: %s, CallingPartyAddress: %s, Data: %x, OptionalParameters: %v}",
+		x.Type,
+		x.ProtocolClass,
+		x.HopCounter,
+		x.CalledPartyAddress,
+		x.CallingPartyAddress,
+		x.Data,
+		x.OptionalParameters,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (x *XUDT) MessageType() MsgType {
+	return MsgTypeXUDT
+}
+
+// MessageTypeName returns the Message Type in string.
+func (x *XUDT) MessageTypeName() string {
+	return "XUDT"
+}
@@ -0,0 +1,119 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestXUDT_RoundTrip(t *testing.T) {
+	called := NewPartyAddressSSN(8)
+	calling := NewPartyAddressSSN(6)
+	x := NewXUDT(1, 15, called, calling, []byte("hello"), nil)
+
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got, err := ParseXUDT(b)
+	if err != nil {
+		t.Fatalf("ParseXUDT: %v", err)
+	}
+	if !bytes.Equal(got.Data, []byte("hello")) || got.ProtocolClass != 1 || got.HopCounter != 15 {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestXUDT_SegmentationRoundTrip(t *testing.T) {
+	seg := &Segmentation{First: true, Class: 1, RemainingSegments: 2, Reference: [4]byte{0x01, 0x02, 0x03, 0x04}}
+	segBytes, err := seg.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Segmentation.MarshalBinary: %v", err)
+	}
+
+	called := NewPartyAddressSSN(8)
+	calling := NewPartyAddressSSN(6)
+	params := []OptionalParameter{{Name: ParamSegmentation, Length: uint8(len(segBytes)), Value: segBytes}}
+	x := NewXUDT(1, 15, called, calling, []byte("part1"), params)
+
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got, err := ParseXUDT(b)
+	if err != nil {
+		t.Fatalf("ParseXUDT: %v", err)
+	}
+
+	gotSeg, ok := got.Segmentation()
+	if !ok {
+		t.Fatal("Segmentation() = false, want true")
+	}
+	if !gotSeg.First || gotSeg.RemainingSegments != 2 || gotSeg.Reference != seg.Reference {
+		t.Errorf("got %s, want %s", gotSeg, seg)
+	}
+}
+
+func TestXUDTS_RoundTrip(t *testing.T) {
+	called := NewPartyAddressSSN(8)
+	calling := NewPartyAddressSSN(6)
+	x := NewXUDTS(1, 15, called, calling, []byte("hello"), nil)
+
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got, err := ParseXUDTS(b)
+	if err != nil {
+		t.Fatalf("ParseXUDTS: %v", err)
+	}
+	if !bytes.Equal(got.Data, []byte("hello")) || got.ReturnCause != 1 {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestLUDT_RoundTrip(t *testing.T) {
+	called := NewPartyAddressSSN(8)
+	calling := NewPartyAddressSSN(6)
+	data := bytes.Repeat([]byte{0xAB}, 300)
+	l := NewLUDT(1, 15, called, calling, data, nil)
+
+	b, err := l.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got, err := ParseLUDT(b)
+	if err != nil {
+		t.Fatalf("ParseLUDT: %v", err)
+	}
+	if !bytes.Equal(got.Data, data) || got.ProtocolClass != 1 {
+		t.Errorf("got ProtocolClass=%d, len(Data)=%d", got.ProtocolClass, len(got.Data))
+	}
+}
+
+func TestLUDTS_RoundTrip(t *testing.T) {
+	called := NewPartyAddressSSN(8)
+	calling := NewPartyAddressSSN(6)
+	data := bytes.Repeat([]byte{0xCD}, 300)
+	l := NewLUDTS(1, 15, called, calling, data, nil)
+
+	b, err := l.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got, err := ParseLUDTS(b)
+	if err != nil {
+		t.Fatalf("ParseLUDTS: %v", err)
+	}
+	if !bytes.Equal(got.Data, data) || got.ReturnCause != 1 {
+		t.Errorf("got ReturnCause=%d, len(Data)=%d", got.ReturnCause, len(got.Data))
+	}
+}
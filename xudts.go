@@ -0,0 +1,235 @@
+// Copyright 2019-2024 go-sccp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package sccp
+
+import (
+	"fmt"
+	"io"
+)
+
+// XUDTS represents a SCCP Extended Unitdata Service message, returned to the
+// originator when an XUDT could not be delivered (e.g. reassembly failure,
+// see ReturnCause).
+type XUDTS struct {
+	Type                MsgType
+	ReturnCause         uint8
+	HopCounter          uint8
+	Ptr1                uint8 // -> CalledPartyAddress
+	Ptr2                uint8 // -> CallingPartyAddress
+	Ptr3                uint8 // -> Data
+	Ptr4                uint8 // -> optional part, 0 if absent
+	CalledPartyAddress  *PartyAddress
+	CallingPartyAddress *PartyAddress
+	Data                []byte
+	OptionalParameters  []OptionalParameter
+}
+
+// NewXUDTS creates a new XUDTS.
+func NewXUDTS(returnCause, hopCounter uint8, calledPartyAddress, callingPartyAddress *PartyAddress, data []byte, optionalParameters []OptionalParameter) *XUDTS {
+	x := &XUDTS{
+		Type:                MsgTypeXUDTS,
+		ReturnCause:         returnCause,
+		HopCounter:          hopCounter,
+		CalledPartyAddress:  calledPartyAddress,
+		CallingPartyAddress: callingPartyAddress,
+		Data:                data,
+		OptionalParameters:  optionalParameters,
+	}
+	x.updatePointers()
+
+	return x
+}
+
+func (x *XUDTS) updatePointers() {
+	offsetCalled := 7
+	x.Ptr1 = uint8(offsetCalled - 3)
+
+	offsetCalling := offsetCalled + 1 + x.CalledPartyAddress.MarshalLen()
+	x.Ptr2 = uint8(offsetCalling - 4)
+
+	offsetData := offsetCalling + 1 + x.CallingPartyAddress.MarshalLen()
+	x.Ptr3 = uint8(offsetData - 5)
+
+	if len(x.OptionalParameters) == 0 {
+		x.Ptr4 = 0
+		return
+	}
+
+	offsetOptional := offsetData + 1 + len(x.Data)
+	x.Ptr4 = uint8(offsetOptional - 6)
+}
+
+// MarshalBinary returns the byte sequence generated from a XUDTS instance.
+func (x *XUDTS) MarshalBinary() ([]byte, error) {
+	b := make([]byte, x.MarshalLen())
+	if err := x.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (x *XUDTS) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = uint8(x.Type)
+	b[1] = x.ReturnCause
+	b[2] = x.HopCounter
+	b[3] = x.Ptr1
+	b[4] = x.Ptr2
+	b[5] = x.Ptr3
+	b[6] = x.Ptr4
+
+	calledLen := x.CalledPartyAddress.MarshalLen()
+	calledOffset := int(x.Ptr1) + 3
+	if l < calledOffset+1+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	b[calledOffset] = uint8(calledLen)
+	if err := x.CalledPartyAddress.MarshalTo(b[calledOffset+1 : calledOffset+1+calledLen]); err != nil {
+		return err
+	}
+
+	callingLen := x.CallingPartyAddress.MarshalLen()
+	callingOffset := int(x.Ptr2) + 4
+	if l < callingOffset+1+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	b[callingOffset] = uint8(callingLen)
+	if err := x.CallingPartyAddress.MarshalTo(b[callingOffset+1 : callingOffset+1+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(x.Ptr3) + 5
+	if l < dataOffset+1+len(x.Data) {
+		return io.ErrUnexpectedEOF
+	}
+	b[dataOffset] = uint8(len(x.Data))
+	copy(b[dataOffset+1:], x.Data)
+
+	if x.Ptr4 != 0 {
+		optOffset := int(x.Ptr4) + 6
+		opt := MarshalOptionalParameters(x.OptionalParameters)
+		if l < optOffset+len(opt) {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[optOffset:], opt)
+	}
+
+	return nil
+}
+
+// ParseXUDTS decodes given byte sequence as a SCCP XUDTS.
+func ParseXUDTS(b []byte) (*XUDTS, error) {
+	x := &XUDTS{}
+	if err := x.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SCCP XUDTS.
+func (x *XUDTS) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	x.Type = MsgType(b[0])
+	x.ReturnCause = b[1]
+	x.HopCounter = b[2]
+	x.Ptr1 = b[3]
+	x.Ptr2 = b[4]
+	x.Ptr3 = b[5]
+	x.Ptr4 = b[6]
+
+	calledOffset := int(x.Ptr1) + 3
+	if l <= calledOffset {
+		return io.ErrUnexpectedEOF
+	}
+	calledLen := int(b[calledOffset])
+	if l < calledOffset+1+calledLen {
+		return io.ErrUnexpectedEOF
+	}
+	x.CalledPartyAddress = &PartyAddress{}
+	if err := x.CalledPartyAddress.UnmarshalBinary(b[calledOffset+1 : calledOffset+1+calledLen]); err != nil {
+		return err
+	}
+
+	callingOffset := int(x.Ptr2) + 4
+	if l <= callingOffset {
+		return io.ErrUnexpectedEOF
+	}
+	callingLen := int(b[callingOffset])
+	if l < callingOffset+1+callingLen {
+		return io.ErrUnexpectedEOF
+	}
+	x.CallingPartyAddress = &PartyAddress{}
+	if err := x.CallingPartyAddress.UnmarshalBinary(b[callingOffset+1 : callingOffset+1+callingLen]); err != nil {
+		return err
+	}
+
+	dataOffset := int(x.Ptr3) + 5
+	if l <= dataOffset {
+		return io.ErrUnexpectedEOF
+	}
+	dataLen := int(b[dataOffset])
+	if l < dataOffset+1+dataLen {
+		return io.ErrUnexpectedEOF
+	}
+	x.Data = b[dataOffset+1 : dataOffset+1+dataLen]
+
+	if x.Ptr4 != 0 {
+		optOffset := int(x.Ptr4) + 6
+		if l < optOffset {
+			return io.ErrUnexpectedEOF
+		}
+		params, err := ParseOptionalParameters(b[optOffset:])
+		if err != nil {
+			return err
+		}
+		x.OptionalParameters = params
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length.
+func (x *XUDTS) MarshalLen() int {
+	l := 7 + 1 + x.CalledPartyAddress.MarshalLen() + 1 + x.CallingPartyAddress.MarshalLen() + 1 + len(x.Data)
+	if len(x.OptionalParameters) > 0 {
+		l += len(MarshalOptionalParameters(x.OptionalParameters))
+	}
+
+	return l
+}
+
+// String returns the XUDTS values in human readable format.
+func (x *XUDTS) String() string {
+	return fmt.Sprintf("{Type: %d, ReturnCause: %d, HopCounter: %d, CalledPartyAddress: %s, CallingPartyAddress: %s, Data: %x, OptionalParameters: %v}",
+		x.Type,
+		x.ReturnCause,
+		x.HopCounter,
+		x.CalledPartyAddress,
+		x.CallingPartyAddress,
+		x.Data,
+		x.OptionalParameters,
+	)
+}
+
+// MessageType returns the Message Type in int.
+func (x *XUDTS) MessageType() MsgType {
+	return MsgTypeXUDTS
+}
+
+// MessageTypeName returns the Message Type in string.
+func (x *XUDTS) MessageTypeName() string {
+	return "XUDTS"
+}